@@ -0,0 +1,189 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+)
+
+/*
+APIError is the error returned by the API calls when the server responds with
+an error status. If the server returns the error as a RFC 7807 problem
+details (application/problem+json), its fields will be parsed into this error.
+
+It can be tested against the sentinel errors ErrNotFound, ErrUnauthorized,
+ErrForbidden, ErrValidation, ErrConflict and ErrServerError using errors.Is().
+The conflicts reported by OpaqueService.Create() also match
+ErrOptimisticLockError.
+*/
+type APIError struct {
+	// The HTTP status code.
+	StatusCode int
+	// The HTTP status line, such as "404 Not Found".
+	Status string
+	// The name of the API operation, such as "Records_List".
+	Operation string
+	// The problem type URI.
+	Type string
+	// Short summary of the problem.
+	Title string
+	// Human readable explanation of the problem.
+	Detail string
+	// URI that identifies this occurrence of the problem.
+	Instance string
+	// Validation errors indexed by the name of the field.
+	Errors map[string][]string
+	// The raw response body.
+	body []byte
+	// The response body decoded as a generic JSON object, if possible.
+	model map[string]models.Object
+	// True if this error must match ErrOptimisticLockError.
+	optimisticLock bool
+}
+
+// Problem details as defined by RFC 7807.
+type problemDetails struct {
+	Type     string              `json:"type,omitempty"`
+	Title    string              `json:"title,omitempty"`
+	Status   int                 `json:"status,omitempty"`
+	Detail   string              `json:"detail,omitempty"`
+	Instance string              `json:"instance,omitempty"`
+	Errors   map[string][]string `json:"errors,omitempty"`
+}
+
+/*
+Creates a new APIError from the given response and its body. The body will be
+parsed as a problem details if the response has a JSON content type.
+*/
+func newAPIError(operation string, resp *http.Response, body []byte) *APIError {
+	e := &APIError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Operation:  operation,
+		body:       body,
+	}
+	contentType := resp.Header.Get("Content-Type")
+	if len(body) == 0 || !strings.Contains(contentType, "json") {
+		return e
+	}
+	var model map[string]models.Object
+	if err := json.Unmarshal(body, &model); err != nil {
+		return e
+	}
+	e.model = model
+	var p problemDetails
+	if err := json.Unmarshal(body, &p); err == nil {
+		e.Type = p.Type
+		e.Title = p.Title
+		e.Detail = p.Detail
+		e.Instance = p.Instance
+		e.Errors = p.Errors
+	}
+	return e
+}
+
+// Returns the error message.
+func (e *APIError) Error() string {
+	var sb strings.Builder
+	if e.Operation != "" {
+		sb.WriteString(e.Operation)
+		sb.WriteString(": ")
+	}
+	if e.Status != "" {
+		sb.WriteString(e.Status)
+	} else {
+		fmt.Fprintf(&sb, "%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	if e.Title != "" {
+		sb.WriteString(": ")
+		sb.WriteString(e.Title)
+	}
+	if e.Detail != "" {
+		sb.WriteString(" - ")
+		sb.WriteString(e.Detail)
+	}
+	if len(e.Errors) > 0 {
+		fields := make([]string, 0, len(e.Errors))
+		for field := range e.Errors {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			fmt.Fprintf(&sb, "; %s: %s", field, strings.Join(e.Errors[field], ", "))
+		}
+	}
+	return sb.String()
+}
+
+/*
+Returns true if target is the sentinel error associated with the status code of
+this error.
+*/
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest ||
+			e.StatusCode == http.StatusUnprocessableEntity
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrOptimisticLockError:
+		return e.optimisticLock
+	case ErrServerError:
+		return e.StatusCode >= 500
+	default:
+		return false
+	}
+}
+
+// Body returns the raw bytes of the response.
+func (e *APIError) Body() []byte {
+	return e.body
+}
+
+/*
+Model returns the body of the response decoded as a generic JSON object. It
+returns nil if the body is not a valid JSON object.
+*/
+func (e *APIError) Model() map[string]models.Object {
+	return e.model
+}
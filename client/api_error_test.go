@@ -0,0 +1,151 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestResponse(statusCode int, contentType string) *http.Response {
+	resp := &http.Response{
+		StatusCode: statusCode,
+		Status:     http.StatusText(statusCode),
+		Header:     make(http.Header),
+	}
+	if contentType != "" {
+		resp.Header.Set("Content-Type", contentType)
+	}
+	return resp
+}
+
+func TestNewAPIError(t *testing.T) {
+	body := []byte(`{
+		"type": "https://tools.ietf.org/html/rfc7231#section-6.5.1",
+		"title": "One or more validation errors occurred.",
+		"status": 400,
+		"detail": "Bad chain",
+		"instance": "/records@x",
+		"errors": {"pageSize": ["Must be positive"], "chain": ["Invalid", "Too short"]}
+	}`)
+	e := newAPIError("Records_List",
+		newTestResponse(400, "application/problem+json; charset=utf-8"), body)
+	assert.Equal(t, 400, e.StatusCode)
+	assert.Equal(t, "Records_List", e.Operation)
+	assert.Equal(t, "https://tools.ietf.org/html/rfc7231#section-6.5.1", e.Type)
+	assert.Equal(t, "One or more validation errors occurred.", e.Title)
+	assert.Equal(t, "Bad chain", e.Detail)
+	assert.Equal(t, "/records@x", e.Instance)
+	assert.Equal(t, map[string][]string{
+		"pageSize": {"Must be positive"},
+		"chain":    {"Invalid", "Too short"},
+	}, e.Errors)
+	assert.Equal(t, body, e.Body())
+	assert.NotNil(t, e.Model())
+	assert.Equal(t, "Records_List: Bad Request: One or more validation errors occurred. - Bad chain; "+
+		"chain: Invalid, Too short; pageSize: Must be positive", e.Error())
+
+	// Not JSON
+	e = newAPIError("Record_Get", newTestResponse(404, "text/plain"), []byte("not found"))
+	assert.Equal(t, "", e.Title)
+	assert.Nil(t, e.Model())
+	assert.Equal(t, "Record_Get: Not Found", e.Error())
+
+	// Invalid JSON
+	e = newAPIError("Record_Get", newTestResponse(404, "application/json"), []byte("{"))
+	assert.Nil(t, e.Model())
+
+	// No status line
+	e = &APIError{StatusCode: 503}
+	assert.Equal(t, "503 Service Unavailable", e.Error())
+}
+
+func TestAPIError_Is(t *testing.T) {
+	sentinels := []error{ErrNotFound, ErrUnauthorized, ErrForbidden, ErrValidation,
+		ErrConflict, ErrServerError}
+	tests := map[int]error{
+		400: ErrValidation,
+		401: ErrUnauthorized,
+		403: ErrForbidden,
+		404: ErrNotFound,
+		409: ErrConflict,
+		422: ErrValidation,
+		500: ErrServerError,
+		503: ErrServerError,
+	}
+	for status, expected := range tests {
+		var err error = &APIError{StatusCode: status}
+		for _, s := range sentinels {
+			assert.Equal(t, s == expected, errors.Is(err, s), "%d %v", status, s)
+		}
+		assert.False(t, errors.Is(err, ErrOptimisticLockError))
+	}
+
+	var err error = &APIError{StatusCode: 409, optimisticLock: true}
+	assert.ErrorIs(t, err, ErrOptimisticLockError)
+	assert.ErrorIs(t, err, ErrConflict)
+}
+
+func TestAPIClient_ToGenericSwaggerError_APIError(t *testing.T) {
+	var c APIClient
+	e := newAPIError("Record_Get", newTestResponse(404, "application/json"), []byte(`{"title":"x"}`))
+	g := c.ToGenericSwaggerError(e)
+	require.NotNil(t, g)
+	assert.Equal(t, "Not Found", g.Error())
+	assert.Equal(t, e.Body(), g.Body())
+	assert.Equal(t, e.Model(), g.Model())
+}
+
+func TestAPIClient_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"title":"Chain not found","status":404}`))
+	}))
+	defer server.Close()
+	cfg := NewConfiguration()
+	cfg.BasePath = server.URL
+	cfg.HTTPClient = server.Client()
+	c := NewAPIClient(cfg)
+
+	_, _, err := c.ChainApi.ChainDetails(context.Background(), "chain")
+	assert.ErrorIs(t, err, ErrNotFound)
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "Chain_Details", apiErr.Operation)
+	assert.Equal(t, "Chain not found", apiErr.Title)
+}
@@ -0,0 +1,338 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+/*
+Key of an entry of the ResponseCache. Only operations that return immutable
+records are cached, thus the operation, the chain and the serial of the record
+are enough to identify the response of a given node. The node and the client
+certificate are also part of the key as some responses, such as the ones of
+RecordApi.RecordGetAsJson(), depend on the keys of the caller.
+*/
+type CacheKey struct {
+	// Base URL of the node (Configuration.BasePath).
+	Node string
+	// SHA-256 of the client certificate in hex. It is empty if there is no
+	// client certificate.
+	Identity string
+	// ID of the operation, e.g. "Record_Get".
+	Operation string
+	// ID of the chain.
+	Chain string
+	// Serial of the record.
+	Serial int64
+}
+
+/*
+A cached response. It holds the headers and the body of the original response.
+*/
+type CachedResponse struct {
+	Header http.Header `json:"header,omitempty"`
+	Body   []byte      `json:"body"`
+}
+
+/*
+Cache used to store the responses of the operations that return immutable
+records. It is used by RecordApi.RecordGet(), RecordApi.RecordGetAsJson(),
+JsonDocumentApi.JsonDocumentsGet() and OpaqueApi.Get(). Lists, queries and
+chain summaries are never cached.
+
+Cached responses are returned without calling the middlewares added by
+APIClient.Use(), thus they only see the calls that reach the node.
+
+Implementations must be safe for concurrent use.
+*/
+type ResponseCache interface {
+	// Returns the cached response or false if it is not in the cache.
+	Get(key CacheKey) (*CachedResponse, bool)
+	// Adds the response to the cache.
+	Put(key CacheKey, response *CachedResponse)
+}
+
+/*
+In-memory ResponseCache that discards the least recently used entries when
+its capacity is reached.
+*/
+type LRUCache struct {
+	mutex    sync.Mutex
+	capacity int
+	entries  map[CacheKey]*list.Element
+	order    *list.List
+}
+
+type lruEntry struct {
+	key      CacheKey
+	response *CachedResponse
+}
+
+/*
+Creates a new LRUCache that holds at most capacity entries. It panics if
+capacity is not positive.
+*/
+func NewLRUCache(capacity int) *LRUCache {
+	if capacity <= 0 {
+		panic("the capacity of the cache must be positive")
+	}
+	return &LRUCache{
+		capacity: capacity,
+		entries:  make(map[CacheKey]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Implements ResponseCache.Get().
+func (c *LRUCache) Get(key CacheKey) (*CachedResponse, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*lruEntry).response, true
+}
+
+// Implements ResponseCache.Put().
+func (c *LRUCache) Put(key CacheKey, response *CachedResponse) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if e, ok := c.entries[key]; ok {
+		e.Value.(*lruEntry).response = response
+		c.order.MoveToFront(e)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, response: response})
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// Returns the number of entries in the cache.
+func (c *LRUCache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.order.Len()
+}
+
+/*
+ResponseCache that stores each entry as a JSON file inside a directory, thus
+the cached records survive restarts. It never evicts entries.
+
+The entries hold the response bodies as returned by the node, including the
+decrypted payloads returned by RecordApi.RecordGetAsJson() to the owner of the
+reading keys. They are stored in plaintext, thus the files are created with the
+permission 0600 and the directories with 0700, and the cache directory must be
+protected as the client key itself.
+
+Write errors are ignored, as the cache is only an optimization.
+*/
+type DirCache struct {
+	dir string
+}
+
+/*
+Creates a new DirCache that stores its entries inside dir. The directory is
+created if it does not exist.
+*/
+func NewDirCache(dir string) (*DirCache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &DirCache{dir: dir}, nil
+}
+
+/*
+Returns the file of the given key. The name of the file is the hash of the key,
+thus no part of the key can escape the cache directory.
+*/
+func (c *DirCache) file(key CacheKey) string {
+	b, _ := json.Marshal(key)
+	h := sha256.Sum256(b)
+	name := hex.EncodeToString(h[:])
+	return filepath.Join(c.dir, name[:2], name+".json")
+}
+
+// Implements ResponseCache.Get().
+func (c *DirCache) Get(key CacheKey) (*CachedResponse, bool) {
+	b, err := os.ReadFile(c.file(key))
+	if err != nil {
+		return nil, false
+	}
+	var response CachedResponse
+	if err := json.Unmarshal(b, &response); err != nil {
+		return nil, false
+	}
+	return &response, true
+}
+
+// Implements ResponseCache.Put().
+func (c *DirCache) Put(key CacheKey, response *CachedResponse) {
+	b, err := json.Marshal(response)
+	if err != nil {
+		return
+	}
+	file := c.file(key)
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return
+	}
+	// Write to a temporary file first to avoid partial entries.
+	tmp, err := os.CreateTemp(filepath.Dir(file), ".tmp-*")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(b)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), file)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+/*
+Returns the response cache used by this client or nil if the responses are
+not cached.
+*/
+func (c *APIClient) Cache() ResponseCache {
+	return c.cache
+}
+
+/*
+Sets the response cache used by this client. Set it to nil to disable the
+cache. This method is not safe to be called concurrently with API calls.
+*/
+func (c *APIClient) SetCache(cache ResponseCache) {
+	c.cache = cache
+}
+
+/*
+Creates the response cache according to the configuration. If the cache
+directory cannot be created, the error is returned by all calls.
+*/
+func (c *APIClient) initCache() {
+	c.cache, c.cacheErr = nil, nil
+	if c.cfg.CacheDir != "" {
+		cache, err := NewDirCache(c.cfg.CacheDir)
+		if err != nil {
+			c.cacheErr = fmt.Errorf("unable to create the cache directory: %w", err)
+		} else {
+			c.cache = cache
+		}
+		return
+	}
+	if c.cfg.CacheSize > 0 {
+		c.cache = NewLRUCache(c.cfg.CacheSize)
+	}
+}
+
+/*
+Returns the fingerprint of the client certificate used by the configuration. It
+returns an empty string if there is no client certificate.
+*/
+func (c *Configuration) clientIdentity() string {
+	var cert *tls.Certificate
+	if c.CertificateSource != nil {
+		cert, _ = c.CertificateSource.GetClientCertificate(&tls.CertificateRequestInfo{})
+	} else if len(c.ClientCertificates) > 0 {
+		cert = &c.ClientCertificates[0]
+	}
+	if cert == nil || len(cert.Certificate) == 0 {
+		return ""
+	}
+	h := sha256.Sum256(cert.Certificate[0])
+	return hex.EncodeToString(h[:])
+}
+
+/*
+Works like callAPI() but returns the cached response of the record if available.
+Successful responses are added to the cache.
+*/
+func (c *APIClient) callCachedAPI(operation string, chain string, serial int64, request *http.Request) (*http.Response, error) {
+	cache := c.cache
+	if cache == nil {
+		return c.callAPI(operation, request)
+	}
+	key := CacheKey{
+		Node:      c.cfg.BasePath,
+		Identity:  c.cfg.clientIdentity(),
+		Operation: operation,
+		Chain:     chain,
+		Serial:    serial,
+	}
+	if cached, ok := cache.Get(key); ok {
+		return &http.Response{
+			Status:        "200 OK",
+			StatusCode:    http.StatusOK,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        cached.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(cached.Body)),
+			ContentLength: int64(len(cached.Body)),
+			Request:       request,
+		}, nil
+	}
+	resp, err := c.callAPI(key.Operation, request)
+	if err != nil || resp == nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return resp, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+	header.Del("Date")
+	cache.Put(key, &CachedResponse{Header: header, Body: body})
+	return resp, nil
+}
@@ -0,0 +1,273 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCacheTestClient(t *testing.T, cfg *Configuration, calls *int32) *APIClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		switch r.URL.Path {
+		case "/records@chain/1", "/records@chain/asJson/1", "/jsonDocuments@chain/1":
+			w.Header().Set("Content-Type", "application/json")
+			io.WriteString(w, `{"chainId":"chain","serial":1}`)
+		case "/records@chain":
+			w.Header().Set("Content-Type", "application/json")
+			io.WriteString(w, `{"items":[]}`)
+		case "/opaque/chain@1":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("x-app-id", "8")
+			w.Header().Set("x-payload-type-id", "100")
+			w.Write([]byte{1, 2, 3})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	cfg.BasePath = server.URL
+	cfg.HTTPClient = server.Client()
+	return NewAPIClient(cfg)
+}
+
+func TestLRUCache(t *testing.T) {
+	c := NewLRUCache(2)
+	k1 := CacheKey{Operation: "Record_Get", Chain: "chain", Serial: 1}
+	k2 := CacheKey{Operation: "Record_Get", Chain: "chain", Serial: 2}
+	k3 := CacheKey{Operation: "Record_Get", Chain: "chain", Serial: 3}
+
+	_, ok := c.Get(k1)
+	assert.False(t, ok)
+
+	c.Put(k1, &CachedResponse{Body: []byte("1")})
+	c.Put(k2, &CachedResponse{Body: []byte("2")})
+	r, ok := c.Get(k1)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), r.Body)
+
+	// k2 is the least recently used
+	c.Put(k3, &CachedResponse{Body: []byte("3")})
+	assert.Equal(t, 2, c.Len())
+	_, ok = c.Get(k2)
+	assert.False(t, ok)
+	_, ok = c.Get(k1)
+	assert.True(t, ok)
+	_, ok = c.Get(k3)
+	assert.True(t, ok)
+
+	c.Put(k3, &CachedResponse{Body: []byte("4")})
+	r, _ = c.Get(k3)
+	assert.Equal(t, []byte("4"), r.Body)
+	assert.Equal(t, 2, c.Len())
+
+	assert.Panics(t, func() { NewLRUCache(0) })
+}
+
+func TestDirCache(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "cache")
+	c, err := NewDirCache(dir)
+	require.Nil(t, err)
+	k := CacheKey{Node: "https://node", Operation: "Record_Get", Chain: "..", Serial: 1}
+
+	_, ok := c.Get(k)
+	assert.False(t, ok)
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	c.Put(k, &CachedResponse{Header: header, Body: []byte("{}")})
+
+	// Reopen
+	c, err = NewDirCache(dir)
+	require.Nil(t, err)
+	r, ok := c.Get(k)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("{}"), r.Body)
+	assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+	for _, other := range []CacheKey{
+		{Node: "https://node", Operation: "Record_Get", Chain: "..", Serial: 2},
+		{Node: "https://other", Operation: "Record_Get", Chain: "..", Serial: 1},
+		{Node: "https://node", Identity: "x", Operation: "Record_Get", Chain: "..", Serial: 1},
+	} {
+		_, ok = c.Get(other)
+		assert.False(t, ok)
+	}
+
+	// The entries are readable only by the owner
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(c.file(k))
+		require.Nil(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+		info, err = os.Stat(filepath.Dir(c.file(k)))
+		require.Nil(t, err)
+		assert.Equal(t, os.FileMode(0700), info.Mode().Perm())
+	}
+
+	// The entries never leave the cache directory
+	for _, chain := range []string{"..", "../..", "a/../../b", `..\..`} {
+		file := c.file(CacheKey{Operation: "../Record_Get", Chain: chain, Serial: 1})
+		rel, err := filepath.Rel(dir, file)
+		require.Nil(t, err)
+		assert.False(t, strings.HasPrefix(rel, ".."), chain)
+		assert.Len(t, strings.Split(filepath.ToSlash(rel), "/"), 2, chain)
+	}
+}
+
+func TestAPIClient_initCache(t *testing.T) {
+	cfg := NewConfiguration()
+	assert.Nil(t, NewAPIClient(cfg).Cache())
+
+	cfg.CacheSize = 10
+	assert.IsType(t, &LRUCache{}, NewAPIClient(cfg).Cache())
+
+	cfg.CacheDir = t.TempDir()
+	assert.IsType(t, &DirCache{}, NewAPIClient(cfg).Cache())
+
+	// An invalid directory is reported by all calls
+	file := filepath.Join(t.TempDir(), "file")
+	require.Nil(t, os.WriteFile(file, nil, 0600))
+	cfg.CacheDir = filepath.Join(file, "cache")
+	c := NewAPIClient(cfg)
+	assert.Nil(t, c.Cache())
+	_, _, err := c.NodeApi.ApiVersion(context.Background())
+	assert.ErrorContains(t, err, "unable to create the cache directory")
+	cfg.CacheDir = ""
+
+	c = NewAPIClient(cfg)
+	c.SetCache(nil)
+	assert.Nil(t, c.Cache())
+}
+
+func TestAPIClient_callCachedAPI(t *testing.T) {
+	var calls int32
+	cfg := NewConfiguration()
+	cfg.CacheSize = 10
+	c := newCacheTestClient(t, cfg, &calls)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		rec, resp, err := c.RecordApi.RecordGet(ctx, "chain", 1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, int64(1), rec.Serial)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	// Different operation
+	doc, _, err := c.JsonDocumentApi.JsonDocumentsGet(ctx, "chain", 1)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), doc.Serial)
+	_, _, err = c.JsonDocumentApi.JsonDocumentsGet(ctx, "chain", 1)
+	require.Nil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	// Headers are preserved
+	for i := 0; i < 2; i++ {
+		payload, appId, typeId, _, err := c.OpaqueApi.Get(ctx, "chain", 1)
+		require.Nil(t, err)
+		assert.Equal(t, []byte{1, 2, 3}, payload)
+		assert.Equal(t, int64(8), appId)
+		assert.Equal(t, int64(100), typeId)
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+
+	// Errors are not cached
+	for i := 0; i < 2; i++ {
+		_, _, err = c.RecordApi.RecordGet(ctx, "chain", 2)
+		assert.ErrorIs(t, err, ErrNotFound)
+	}
+	assert.Equal(t, int32(5), atomic.LoadInt32(&calls))
+
+	// Lists are not cached
+	for i := 0; i < 2; i++ {
+		_, _, err = c.RecordApi.RecordsList(ctx, "chain", nil)
+		require.Nil(t, err)
+	}
+	assert.Equal(t, int32(7), atomic.LoadInt32(&calls))
+}
+
+func TestAPIClient_callCachedAPI_Partitions(t *testing.T) {
+	var calls int32
+	cfg := NewConfiguration()
+	cfg.CacheSize = 10
+	c := newCacheTestClient(t, cfg, &calls)
+	ctx := context.Background()
+
+	get := func() {
+		_, _, err := c.RecordApi.RecordGetAsJson(ctx, "chain", 1)
+		require.Nil(t, err)
+	}
+	get()
+	get()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	// Another client certificate
+	cert, err := tls.LoadX509KeyPair(path.Join("..", "samples", "cert.pem"), path.Join("..", "samples", "key.pem"))
+	require.Nil(t, err)
+	cfg.ClientCertificates = []tls.Certificate{cert}
+	get()
+	get()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	// Another node
+	var otherCalls int32
+	other := newCacheTestClient(t, NewConfiguration(), &otherCalls)
+	c.ChangeBasePath(other.cfg.BasePath)
+	get()
+	get()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&otherCalls))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestAPIClient_callCachedAPI_NoCache(t *testing.T) {
+	var calls int32
+	c := newCacheTestClient(t, NewConfiguration(), &calls)
+
+	for i := 0; i < 2; i++ {
+		_, _, err := c.RecordApi.RecordGet(context.Background(), "chain", 1)
+		require.Nil(t, err)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
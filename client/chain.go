@@ -94,7 +94,7 @@ func (a *ChainApiService) ChainActiveAppsAdd(ctx context.Context, chain string,
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Chain_ActiveApps_Add", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -114,32 +114,7 @@ func (a *ChainApiService) ChainActiveAppsAdd(ctx context.Context, chain string,
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v []int64
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Chain_ActiveApps_Add", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -186,7 +161,7 @@ func (a *ChainApiService) ChainActiveAppsList(ctx context.Context, chain string)
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Chain_ActiveApps_List", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -206,32 +181,7 @@ func (a *ChainApiService) ChainActiveAppsList(ctx context.Context, chain string)
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v []int64
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Chain_ActiveApps_List", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -277,7 +227,7 @@ func (a *ChainApiService) ChainCreate(ctx context.Context, creationParams *model
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Chain_Create", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -297,32 +247,7 @@ func (a *ChainApiService) ChainCreate(ctx context.Context, creationParams *model
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 201:
-			var v models.ChainCreatedModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Chain_Create", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -369,7 +294,7 @@ func (a *ChainApiService) ChainDetails(ctx context.Context, chain string) (model
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Chain_Details", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -389,32 +314,7 @@ func (a *ChainApiService) ChainDetails(ctx context.Context, chain string) (model
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v models.ChainSummaryModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Chain_Details", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -461,7 +361,7 @@ func (a *ChainApiService) ChainInterlockingAdd(ctx context.Context, chain string
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Chain_Interlocking_Add", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -481,32 +381,7 @@ func (a *ChainApiService) ChainInterlockingAdd(ctx context.Context, chain string
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v models.InterlockingRecordModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Chain_Interlocking_Add", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -571,7 +446,7 @@ func (a *ChainApiService) ChainInterlockingsList(ctx context.Context, chain stri
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Chain_Interlockings_List", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -591,32 +466,7 @@ func (a *ChainApiService) ChainInterlockingsList(ctx context.Context, chain stri
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v models.InterlockingRecordModelPageOf
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Chain_Interlockings_List", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -664,7 +514,7 @@ func (a *ChainApiService) ChainPermittedKeysAdd(ctx context.Context, chain strin
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Chain_PermittedKeys_Add", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -684,32 +534,7 @@ func (a *ChainApiService) ChainPermittedKeysAdd(ctx context.Context, chain strin
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v []models.KeyDetailsModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Chain_PermittedKeys_Add", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -756,7 +581,7 @@ func (a *ChainApiService) ChainPermittedKeysList(ctx context.Context, chain stri
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Chain_PermittedKeys_List", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -776,32 +601,7 @@ func (a *ChainApiService) ChainPermittedKeysList(ctx context.Context, chain stri
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v []models.KeyDetailsModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Chain_PermittedKeys_List", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -847,7 +647,7 @@ func (a *ChainApiService) ChainsList(ctx context.Context) ([]models.ChainIdModel
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Chains_List", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -867,32 +667,7 @@ func (a *ChainApiService) ChainsList(ctx context.Context) ([]models.ChainIdModel
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v []models.ChainIdModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Chains_List", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -0,0 +1,154 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+/*
+Stores the serial of the last record delivered by RecordApi.Follow() for a
+given key. Implementations must be safe for concurrent use and Save must be
+durable when it returns, as Follow relies on it to resume after a restart.
+*/
+type CheckpointStore interface {
+	/*
+		Returns the last saved serial for the given key. The second return value
+		is false if there is no checkpoint for the key.
+	*/
+	Load(ctx context.Context, key string) (int64, bool, error)
+	// Saves the serial for the given key.
+	Save(ctx context.Context, key string, serial int64) error
+}
+
+/*
+CheckpointStore that keeps the checkpoints in memory. It is useful for tests
+and for processes that do not need to resume after a restart.
+*/
+type MemoryCheckpointStore struct {
+	mutex       sync.Mutex
+	checkpoints map[string]int64
+}
+
+/*
+Creates a new MemoryCheckpointStore.
+*/
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{checkpoints: make(map[string]int64)}
+}
+
+// Implements CheckpointStore.Load().
+func (s *MemoryCheckpointStore) Load(ctx context.Context, key string) (int64, bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	serial, ok := s.checkpoints[key]
+	return serial, ok, nil
+}
+
+// Implements CheckpointStore.Save().
+func (s *MemoryCheckpointStore) Save(ctx context.Context, key string, serial int64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.checkpoints[key] = serial
+	return nil
+}
+
+/*
+CheckpointStore that keeps each checkpoint in its own file inside a directory.
+Files are replaced atomically, thus a crash never leaves a partial checkpoint
+behind.
+*/
+type FileCheckpointStore struct {
+	dir   string
+	mutex sync.Mutex
+}
+
+/*
+Creates a new FileCheckpointStore that uses the given directory. The directory
+is created if required.
+*/
+func NewFileCheckpointStore(dir string) (*FileCheckpointStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &FileCheckpointStore{dir: dir}, nil
+}
+
+// Returns the name of the file of the given key.
+func (s *FileCheckpointStore) file(key string) string {
+	return filepath.Join(s.dir, url.PathEscape(key)+".checkpoint")
+}
+
+// Implements CheckpointStore.Load().
+func (s *FileCheckpointStore) Load(ctx context.Context, key string) (int64, bool, error) {
+	b, err := os.ReadFile(s.file(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+	serial, err := strconv.ParseInt(strings.TrimSpace(string(b)), 10, 64)
+	if err != nil {
+		return 0, false, err
+	}
+	return serial, true, nil
+}
+
+// Implements CheckpointStore.Save().
+func (s *FileCheckpointStore) Save(ctx context.Context, key string, serial int64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	tmp, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.WriteString(strconv.FormatInt(serial, 10))
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), s.file(key))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
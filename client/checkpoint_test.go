@@ -0,0 +1,97 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCheckpointStore(t *testing.T, store CheckpointStore) {
+	ctx := context.Background()
+
+	_, ok, err := store.Load(ctx, "a")
+	require.Nil(t, err)
+	assert.False(t, ok)
+
+	require.Nil(t, store.Save(ctx, "a", 0))
+	require.Nil(t, store.Save(ctx, "b/c", 10))
+	serial, ok, err := store.Load(ctx, "a")
+	require.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(0), serial)
+
+	require.Nil(t, store.Save(ctx, "a", 20))
+	serial, ok, err = store.Load(ctx, "a")
+	require.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(20), serial)
+	serial, ok, err = store.Load(ctx, "b/c")
+	require.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(10), serial)
+}
+
+func TestMemoryCheckpointStore(t *testing.T) {
+	testCheckpointStore(t, NewMemoryCheckpointStore())
+}
+
+func TestFileCheckpointStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "checkpoints")
+	store, err := NewFileCheckpointStore(dir)
+	require.Nil(t, err)
+	testCheckpointStore(t, store)
+
+	// Survives a restart
+	store, err = NewFileCheckpointStore(dir)
+	require.Nil(t, err)
+	serial, ok, err := store.Load(context.Background(), "a")
+	require.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(20), serial)
+
+	// No temporary files left behind
+	entries, err := os.ReadDir(dir)
+	require.Nil(t, err)
+	assert.Len(t, entries, 2)
+
+	require.Nil(t, os.WriteFile(store.file("bad"), []byte("x"), 0600))
+	_, _, err = store.Load(context.Background(), "bad")
+	assert.Error(t, err)
+
+	_, err = NewFileCheckpointStore(filepath.Join(store.file("a"), "x"))
+	assert.Error(t, err)
+}
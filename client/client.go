@@ -47,6 +47,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -74,6 +75,25 @@ type APIClient struct {
 	RecordApi *RecordApiService
 
 	OpaqueApi *OpaqueService
+
+	// Middlewares used by each call.
+	middlewares []Middleware
+
+	// Router used when Configuration.Endpoints is set.
+	router *failoverRouter
+	// Error found while creating the router.
+	routerErr error
+
+	// Cache of the immutable records.
+	cache ResponseCache
+	// Error found while creating the cache.
+	cacheErr error
+
+	// Network of the node, used to check the record references.
+	networkMu sync.Mutex
+	network   string
+	// Pending call that fetches the network, shared by concurrent callers.
+	networkCall *networkCall
 }
 
 type service struct {
@@ -98,16 +118,38 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.NodeApi = (*NodeApiService)(&c.common)
 	c.RecordApi = (*RecordApiService)(&c.common)
 	c.OpaqueApi = (*OpaqueService)(&c.common)
+	c.initRouter()
+	c.initCache()
 	return c
 }
 
+// Initializes the failover router if the configuration has endpoints.
+func (c *APIClient) initRouter() {
+	c.router, c.routerErr = nil, nil
+	if len(c.cfg.Endpoints) > 0 {
+		c.router, c.routerErr = newFailoverRouter(c.cfg.BasePath, c.cfg.Endpoints,
+			c.cfg.FailoverCooldown)
+	}
+}
+
 // This helper function tries to convert a given error into a
 // GenericSwaggerError. Returns nil if the type does not match.
+//
+// Deprecated: The API calls now return *APIError. Use errors.As() instead.
 func (c *APIClient) ToGenericSwaggerError(err error) *GenericSwaggerError {
 	if e1, ok := err.(*GenericSwaggerError); ok {
 		return e1
 	} else if e2, ok := err.(GenericSwaggerError); ok {
 		return &e2
+	} else if e3, ok := err.(*APIError); ok {
+		ret := &GenericSwaggerError{
+			body:  e3.body,
+			error: e3.Status,
+		}
+		if e3.model != nil {
+			ret.model = e3.model
+		}
+		return ret
 	}
 	return nil
 }
@@ -168,14 +210,21 @@ func parameterToString(obj interface{}, collectionFormat string) string {
 	return fmt.Sprintf("%v", obj)
 }
 
-// callAPI do the request.
-func (c *APIClient) callAPI(request *http.Request) (*http.Response, error) {
-	return c.cfg.HTTPClient.Do(request)
+// callAPI do the request, retrying it according to the current RetryPolicy.
+func (c *APIClient) callAPI(operation string, request *http.Request) (*http.Response, error) {
+	return c.doWithRetry(operation, request)
 }
 
-// Change base path to allow switching to mocks
+// Change base path to allow switching to mocks. It also resets the status of
+// the endpoints and forgets the network of the previous node. The cached
+// responses are kept per base path, thus the ones of the previous node will not
+// be used. This method is not safe to be called concurrently with API calls.
 func (c *APIClient) ChangeBasePath(path string) {
 	c.cfg.BasePath = path
+	c.initRouter()
+	c.networkMu.Lock()
+	c.network, c.networkCall = "", nil
+	c.networkMu.Unlock()
 }
 
 // prepareRequest build the request
@@ -0,0 +1,642 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package clienttest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/interlockledger/go-interlockledger-rest-client/client"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+)
+
+/*
+Error returned by the mocks when a method is called but the corresponding
+function field is not set.
+*/
+var ErrUnexpectedCall = errors.New("unexpected call")
+
+func unexpectedCall(method string) error {
+	return fmt.Errorf("%s: %w", method, ErrUnexpectedCall)
+}
+
+/*
+A call recorded by a mock. Args holds all arguments passed to the method
+except the context.
+*/
+type Call struct {
+	Method string
+	Args   []any
+}
+
+/*
+Records the calls made to a mock. It is safe for concurrent use.
+*/
+type Recorder struct {
+	mutex sync.Mutex
+	calls []Call
+}
+
+func (r *Recorder) record(method string, args ...any) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+/*
+Returns all recorded calls in the order they were made.
+*/
+func (r *Recorder) Calls() []Call {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+/*
+Returns the recorded calls to the given method in the order they were made.
+*/
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	var ret []Call
+	for _, c := range r.calls {
+		if c.Method == method {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+/*
+Removes all recorded calls.
+*/
+func (r *Recorder) Reset() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.calls = nil
+}
+
+/*
+Recording mock of client.Client. Each service is a mock of its own, with its
+own Recorder. The Recorder of MockClient itself records only the calls to Do.
+The zero value is ready to use.
+
+Example:
+
+	m := &clienttest.MockClient{}
+	m.RecordAPI.RecordGetFunc = func(ctx context.Context, chain string,
+		serial int64) (models.RecordModel, *http.Response, error) {
+		return models.RecordModel{ChainId: chain, Serial: serial}, nil, nil
+	}
+	service := NewMyService(m)
+	...
+	calls := m.RecordAPI.CallsTo("RecordGet")
+*/
+type MockClient struct {
+	Recorder
+	ChainAPI        MockChainAPI
+	DocumentsAPI    MockDocumentsAPI
+	JsonDocumentAPI MockJsonDocumentAPI
+	NodeAPI         MockNodeAPI
+	RecordAPI       MockRecordAPI
+	OpaqueAPI       MockOpaqueAPI
+	DoFunc          func(ctx context.Context, method, path string, query url.Values, body any, out any) (*http.Response, error)
+}
+
+var _ client.Client = (*MockClient)(nil)
+
+func (m *MockClient) Chain() client.ChainAPI {
+	return &m.ChainAPI
+}
+
+func (m *MockClient) Documents() client.DocumentsAPI {
+	return &m.DocumentsAPI
+}
+
+func (m *MockClient) JsonDocument() client.JsonDocumentAPI {
+	return &m.JsonDocumentAPI
+}
+
+func (m *MockClient) Node() client.NodeAPI {
+	return &m.NodeAPI
+}
+
+func (m *MockClient) Record() client.RecordAPI {
+	return &m.RecordAPI
+}
+
+func (m *MockClient) Opaque() client.OpaqueAPI {
+	return &m.OpaqueAPI
+}
+
+func (m *MockClient) Do(ctx context.Context, method, path string, query url.Values, body any, out any) (*http.Response, error) {
+	m.record("Do", method, path, query, body, out)
+	if m.DoFunc == nil {
+		return nil, unexpectedCall("Client.Do")
+	}
+	return m.DoFunc(ctx, method, path, query, body, out)
+}
+
+/*
+Recording mock of client.ChainAPI. Each call is recorded and forwarded to the
+function field with the same name as the method followed by "Func". If that
+field is nil, the call returns zero values and ErrUnexpectedCall.
+*/
+type MockChainAPI struct {
+	Recorder
+	ChainActiveAppsAddFunc     func(ctx context.Context, chain string, apps []int64) ([]int64, *http.Response, error)
+	ChainActiveAppsListFunc    func(ctx context.Context, chain string) ([]int64, *http.Response, error)
+	ChainCreateFunc            func(ctx context.Context, creationParams *models.ChainCreatedModel) (models.ChainCreatedModel, *http.Response, error)
+	ChainDetailsFunc           func(ctx context.Context, chain string) (models.ChainSummaryModel, *http.Response, error)
+	ChainInterlockingAddFunc   func(ctx context.Context, chain string, params *models.ForceInterlockModel) (models.InterlockingRecordModel, *http.Response, error)
+	ChainInterlockingsListFunc func(ctx context.Context, chain string, params *client.ChainApiChainInterlockingsListOpts) (models.InterlockingRecordModelPageOf, *http.Response, error)
+	ChainPermittedKeysAddFunc  func(ctx context.Context, chain string, keys []models.KeyPermitModel) ([]models.KeyDetailsModel, *http.Response, error)
+	ChainPermittedKeysListFunc func(ctx context.Context, chain string) ([]models.KeyDetailsModel, *http.Response, error)
+	ChainsListFunc             func(ctx context.Context) ([]models.ChainIdModel, *http.Response, error)
+}
+
+func (m *MockChainAPI) ChainActiveAppsAdd(ctx context.Context, chain string, apps []int64) ([]int64, *http.Response, error) {
+	m.record("ChainActiveAppsAdd", chain, apps)
+	if m.ChainActiveAppsAddFunc == nil {
+		return nil, nil, unexpectedCall("ChainAPI.ChainActiveAppsAdd")
+	}
+	return m.ChainActiveAppsAddFunc(ctx, chain, apps)
+}
+
+func (m *MockChainAPI) ChainActiveAppsList(ctx context.Context, chain string) ([]int64, *http.Response, error) {
+	m.record("ChainActiveAppsList", chain)
+	if m.ChainActiveAppsListFunc == nil {
+		return nil, nil, unexpectedCall("ChainAPI.ChainActiveAppsList")
+	}
+	return m.ChainActiveAppsListFunc(ctx, chain)
+}
+
+func (m *MockChainAPI) ChainCreate(ctx context.Context, creationParams *models.ChainCreatedModel) (models.ChainCreatedModel, *http.Response, error) {
+	m.record("ChainCreate", creationParams)
+	if m.ChainCreateFunc == nil {
+		return models.ChainCreatedModel{}, nil, unexpectedCall("ChainAPI.ChainCreate")
+	}
+	return m.ChainCreateFunc(ctx, creationParams)
+}
+
+func (m *MockChainAPI) ChainDetails(ctx context.Context, chain string) (models.ChainSummaryModel, *http.Response, error) {
+	m.record("ChainDetails", chain)
+	if m.ChainDetailsFunc == nil {
+		return models.ChainSummaryModel{}, nil, unexpectedCall("ChainAPI.ChainDetails")
+	}
+	return m.ChainDetailsFunc(ctx, chain)
+}
+
+func (m *MockChainAPI) ChainInterlockingAdd(ctx context.Context, chain string, params *models.ForceInterlockModel) (models.InterlockingRecordModel, *http.Response, error) {
+	m.record("ChainInterlockingAdd", chain, params)
+	if m.ChainInterlockingAddFunc == nil {
+		return models.InterlockingRecordModel{}, nil, unexpectedCall("ChainAPI.ChainInterlockingAdd")
+	}
+	return m.ChainInterlockingAddFunc(ctx, chain, params)
+}
+
+func (m *MockChainAPI) ChainInterlockingsList(ctx context.Context, chain string, params *client.ChainApiChainInterlockingsListOpts) (models.InterlockingRecordModelPageOf, *http.Response, error) {
+	m.record("ChainInterlockingsList", chain, params)
+	if m.ChainInterlockingsListFunc == nil {
+		return models.InterlockingRecordModelPageOf{}, nil, unexpectedCall("ChainAPI.ChainInterlockingsList")
+	}
+	return m.ChainInterlockingsListFunc(ctx, chain, params)
+}
+
+func (m *MockChainAPI) ChainPermittedKeysAdd(ctx context.Context, chain string, keys []models.KeyPermitModel) ([]models.KeyDetailsModel, *http.Response, error) {
+	m.record("ChainPermittedKeysAdd", chain, keys)
+	if m.ChainPermittedKeysAddFunc == nil {
+		return nil, nil, unexpectedCall("ChainAPI.ChainPermittedKeysAdd")
+	}
+	return m.ChainPermittedKeysAddFunc(ctx, chain, keys)
+}
+
+func (m *MockChainAPI) ChainPermittedKeysList(ctx context.Context, chain string) ([]models.KeyDetailsModel, *http.Response, error) {
+	m.record("ChainPermittedKeysList", chain)
+	if m.ChainPermittedKeysListFunc == nil {
+		return nil, nil, unexpectedCall("ChainAPI.ChainPermittedKeysList")
+	}
+	return m.ChainPermittedKeysListFunc(ctx, chain)
+}
+
+func (m *MockChainAPI) ChainsList(ctx context.Context) ([]models.ChainIdModel, *http.Response, error) {
+	m.record("ChainsList")
+	if m.ChainsListFunc == nil {
+		return nil, nil, unexpectedCall("ChainAPI.ChainsList")
+	}
+	return m.ChainsListFunc(ctx)
+}
+
+/*
+Recording mock of client.DocumentsAPI. Each call is recorded and forwarded to the
+function field with the same name as the method followed by "Func". If that
+field is nil, the call returns zero values and ErrUnexpectedCall.
+*/
+type MockDocumentsAPI struct {
+	Recorder
+	DocumentsAddDocumentFunc          func(ctx context.Context, transactionId string, document *client.DocumentsApiDocumentsAddDocumentParams) (models.DocumentsTransactionModel, *http.Response, error)
+	DocumentsBeginTransactionFunc     func(ctx context.Context, body *models.DocumentsBeginTransactionModel) (models.DocumentsTransactionModel, *http.Response, error)
+	DocumentsCommitTransactionFunc    func(ctx context.Context, transactionId string) (string, *http.Response, error)
+	DocumentsGetAllDocumentsFunc      func(ctx context.Context, locator string) (*http.Response, error)
+	DocumentsGetConfigFunc            func(ctx context.Context) (models.DocumentUploadConfiguration, *http.Response, error)
+	DocumentsGetMetadataFunc          func(ctx context.Context, locator string) (models.DocumentsMetadataModel, *http.Response, error)
+	DocumentsGetSingleDocumentFunc    func(ctx context.Context, locator string, index int32) (*http.Response, error)
+	DocumentsGetTransactionStatusFunc func(ctx context.Context, transactionId string) (models.DocumentsTransactionModel, *http.Response, error)
+}
+
+func (m *MockDocumentsAPI) DocumentsAddDocument(ctx context.Context, transactionId string, document *client.DocumentsApiDocumentsAddDocumentParams) (models.DocumentsTransactionModel, *http.Response, error) {
+	m.record("DocumentsAddDocument", transactionId, document)
+	if m.DocumentsAddDocumentFunc == nil {
+		return models.DocumentsTransactionModel{}, nil, unexpectedCall("DocumentsAPI.DocumentsAddDocument")
+	}
+	return m.DocumentsAddDocumentFunc(ctx, transactionId, document)
+}
+
+func (m *MockDocumentsAPI) DocumentsBeginTransaction(ctx context.Context, body *models.DocumentsBeginTransactionModel) (models.DocumentsTransactionModel, *http.Response, error) {
+	m.record("DocumentsBeginTransaction", body)
+	if m.DocumentsBeginTransactionFunc == nil {
+		return models.DocumentsTransactionModel{}, nil, unexpectedCall("DocumentsAPI.DocumentsBeginTransaction")
+	}
+	return m.DocumentsBeginTransactionFunc(ctx, body)
+}
+
+func (m *MockDocumentsAPI) DocumentsCommitTransaction(ctx context.Context, transactionId string) (string, *http.Response, error) {
+	m.record("DocumentsCommitTransaction", transactionId)
+	if m.DocumentsCommitTransactionFunc == nil {
+		return "", nil, unexpectedCall("DocumentsAPI.DocumentsCommitTransaction")
+	}
+	return m.DocumentsCommitTransactionFunc(ctx, transactionId)
+}
+
+func (m *MockDocumentsAPI) DocumentsGetAllDocuments(ctx context.Context, locator string) (*http.Response, error) {
+	m.record("DocumentsGetAllDocuments", locator)
+	if m.DocumentsGetAllDocumentsFunc == nil {
+		return nil, unexpectedCall("DocumentsAPI.DocumentsGetAllDocuments")
+	}
+	return m.DocumentsGetAllDocumentsFunc(ctx, locator)
+}
+
+func (m *MockDocumentsAPI) DocumentsGetConfig(ctx context.Context) (models.DocumentUploadConfiguration, *http.Response, error) {
+	m.record("DocumentsGetConfig")
+	if m.DocumentsGetConfigFunc == nil {
+		return models.DocumentUploadConfiguration{}, nil, unexpectedCall("DocumentsAPI.DocumentsGetConfig")
+	}
+	return m.DocumentsGetConfigFunc(ctx)
+}
+
+func (m *MockDocumentsAPI) DocumentsGetMetadata(ctx context.Context, locator string) (models.DocumentsMetadataModel, *http.Response, error) {
+	m.record("DocumentsGetMetadata", locator)
+	if m.DocumentsGetMetadataFunc == nil {
+		return models.DocumentsMetadataModel{}, nil, unexpectedCall("DocumentsAPI.DocumentsGetMetadata")
+	}
+	return m.DocumentsGetMetadataFunc(ctx, locator)
+}
+
+func (m *MockDocumentsAPI) DocumentsGetSingleDocument(ctx context.Context, locator string, index int32) (*http.Response, error) {
+	m.record("DocumentsGetSingleDocument", locator, index)
+	if m.DocumentsGetSingleDocumentFunc == nil {
+		return nil, unexpectedCall("DocumentsAPI.DocumentsGetSingleDocument")
+	}
+	return m.DocumentsGetSingleDocumentFunc(ctx, locator, index)
+}
+
+func (m *MockDocumentsAPI) DocumentsGetTransactionStatus(ctx context.Context, transactionId string) (models.DocumentsTransactionModel, *http.Response, error) {
+	m.record("DocumentsGetTransactionStatus", transactionId)
+	if m.DocumentsGetTransactionStatusFunc == nil {
+		return models.DocumentsTransactionModel{}, nil, unexpectedCall("DocumentsAPI.DocumentsGetTransactionStatus")
+	}
+	return m.DocumentsGetTransactionStatusFunc(ctx, transactionId)
+}
+
+/*
+Recording mock of client.JsonDocumentAPI. Each call is recorded and forwarded to the
+function field with the same name as the method followed by "Func". If that
+field is nil, the call returns zero values and ErrUnexpectedCall.
+*/
+type MockJsonDocumentAPI struct {
+	Recorder
+	JsonDocumentsAddFunc                 func(ctx context.Context, chain string, jsonDoc models.Object) (models.JsonDocumentModel, *http.Response, error)
+	JsonDocumentsAddWithChainKeysFunc    func(ctx context.Context, chain string, xPubKeyChains []string, jsonDoc models.Object) (models.JsonDocumentModel, *http.Response, error)
+	JsonDocumentsAddWithIndirectKeysFunc func(ctx context.Context, chain string, xPubKeyReferences []string, jsonDoc models.Object) (models.JsonDocumentModel, *http.Response, error)
+	JsonDocumentsAddWithKeyFunc          func(ctx context.Context, chain string, xPubKey string, xPubKeyId string, jsonDoc models.Object) (models.JsonDocumentModel, *http.Response, error)
+	JsonDocumentsAllowReadersFunc        func(ctx context.Context, chain string, allowedReaders *models.AllowedReadersModel) (string, *http.Response, error)
+	JsonDocumentsGetFunc                 func(ctx context.Context, chain string, serial int64) (models.JsonDocumentModel, *http.Response, error)
+}
+
+func (m *MockJsonDocumentAPI) JsonDocumentsAdd(ctx context.Context, chain string, jsonDoc models.Object) (models.JsonDocumentModel, *http.Response, error) {
+	m.record("JsonDocumentsAdd", chain, jsonDoc)
+	if m.JsonDocumentsAddFunc == nil {
+		return models.JsonDocumentModel{}, nil, unexpectedCall("JsonDocumentAPI.JsonDocumentsAdd")
+	}
+	return m.JsonDocumentsAddFunc(ctx, chain, jsonDoc)
+}
+
+func (m *MockJsonDocumentAPI) JsonDocumentsAddWithChainKeys(ctx context.Context, chain string, xPubKeyChains []string, jsonDoc models.Object) (models.JsonDocumentModel, *http.Response, error) {
+	m.record("JsonDocumentsAddWithChainKeys", chain, xPubKeyChains, jsonDoc)
+	if m.JsonDocumentsAddWithChainKeysFunc == nil {
+		return models.JsonDocumentModel{}, nil, unexpectedCall("JsonDocumentAPI.JsonDocumentsAddWithChainKeys")
+	}
+	return m.JsonDocumentsAddWithChainKeysFunc(ctx, chain, xPubKeyChains, jsonDoc)
+}
+
+func (m *MockJsonDocumentAPI) JsonDocumentsAddWithIndirectKeys(ctx context.Context, chain string, xPubKeyReferences []string, jsonDoc models.Object) (models.JsonDocumentModel, *http.Response, error) {
+	m.record("JsonDocumentsAddWithIndirectKeys", chain, xPubKeyReferences, jsonDoc)
+	if m.JsonDocumentsAddWithIndirectKeysFunc == nil {
+		return models.JsonDocumentModel{}, nil, unexpectedCall("JsonDocumentAPI.JsonDocumentsAddWithIndirectKeys")
+	}
+	return m.JsonDocumentsAddWithIndirectKeysFunc(ctx, chain, xPubKeyReferences, jsonDoc)
+}
+
+func (m *MockJsonDocumentAPI) JsonDocumentsAddWithKey(ctx context.Context, chain string, xPubKey string, xPubKeyId string, jsonDoc models.Object) (models.JsonDocumentModel, *http.Response, error) {
+	m.record("JsonDocumentsAddWithKey", chain, xPubKey, xPubKeyId, jsonDoc)
+	if m.JsonDocumentsAddWithKeyFunc == nil {
+		return models.JsonDocumentModel{}, nil, unexpectedCall("JsonDocumentAPI.JsonDocumentsAddWithKey")
+	}
+	return m.JsonDocumentsAddWithKeyFunc(ctx, chain, xPubKey, xPubKeyId, jsonDoc)
+}
+
+func (m *MockJsonDocumentAPI) JsonDocumentsAllowReaders(ctx context.Context, chain string, allowedReaders *models.AllowedReadersModel) (string, *http.Response, error) {
+	m.record("JsonDocumentsAllowReaders", chain, allowedReaders)
+	if m.JsonDocumentsAllowReadersFunc == nil {
+		return "", nil, unexpectedCall("JsonDocumentAPI.JsonDocumentsAllowReaders")
+	}
+	return m.JsonDocumentsAllowReadersFunc(ctx, chain, allowedReaders)
+}
+
+func (m *MockJsonDocumentAPI) JsonDocumentsGet(ctx context.Context, chain string, serial int64) (models.JsonDocumentModel, *http.Response, error) {
+	m.record("JsonDocumentsGet", chain, serial)
+	if m.JsonDocumentsGetFunc == nil {
+		return models.JsonDocumentModel{}, nil, unexpectedCall("JsonDocumentAPI.JsonDocumentsGet")
+	}
+	return m.JsonDocumentsGetFunc(ctx, chain, serial)
+}
+
+/*
+Recording mock of client.NodeAPI. Each call is recorded and forwarded to the
+function field with the same name as the method followed by "Func". If that
+field is nil, the call returns zero values and ErrUnexpectedCall.
+*/
+type MockNodeAPI struct {
+	Recorder
+	ApiVersionFunc        func(ctx context.Context) (string, *http.Response, error)
+	AppsListFunc          func(ctx context.Context) (models.AppsModel, *http.Response, error)
+	InterlockingsListFunc func(ctx context.Context, targetChain string, optionalParams *client.NodeApiInterlockingsListOpts) (models.InterlockingRecordModelPageOf, *http.Response, error)
+	MirrorAddFunc         func(ctx context.Context, chains []string) ([]models.ChainIdModel, *http.Response, error)
+	MirrorsListFunc       func(ctx context.Context) ([]models.ChainIdModel, *http.Response, error)
+	NodeDetailsFunc       func(ctx context.Context) (models.NodeDetailsModel, *http.Response, error)
+	PeersListFunc         func(ctx context.Context) ([]models.PeerModel, *http.Response, error)
+}
+
+func (m *MockNodeAPI) ApiVersion(ctx context.Context) (string, *http.Response, error) {
+	m.record("ApiVersion")
+	if m.ApiVersionFunc == nil {
+		return "", nil, unexpectedCall("NodeAPI.ApiVersion")
+	}
+	return m.ApiVersionFunc(ctx)
+}
+
+func (m *MockNodeAPI) AppsList(ctx context.Context) (models.AppsModel, *http.Response, error) {
+	m.record("AppsList")
+	if m.AppsListFunc == nil {
+		return models.AppsModel{}, nil, unexpectedCall("NodeAPI.AppsList")
+	}
+	return m.AppsListFunc(ctx)
+}
+
+func (m *MockNodeAPI) InterlockingsList(ctx context.Context, targetChain string, optionalParams *client.NodeApiInterlockingsListOpts) (models.InterlockingRecordModelPageOf, *http.Response, error) {
+	m.record("InterlockingsList", targetChain, optionalParams)
+	if m.InterlockingsListFunc == nil {
+		return models.InterlockingRecordModelPageOf{}, nil, unexpectedCall("NodeAPI.InterlockingsList")
+	}
+	return m.InterlockingsListFunc(ctx, targetChain, optionalParams)
+}
+
+func (m *MockNodeAPI) MirrorAdd(ctx context.Context, chains []string) ([]models.ChainIdModel, *http.Response, error) {
+	m.record("MirrorAdd", chains)
+	if m.MirrorAddFunc == nil {
+		return nil, nil, unexpectedCall("NodeAPI.MirrorAdd")
+	}
+	return m.MirrorAddFunc(ctx, chains)
+}
+
+func (m *MockNodeAPI) MirrorsList(ctx context.Context) ([]models.ChainIdModel, *http.Response, error) {
+	m.record("MirrorsList")
+	if m.MirrorsListFunc == nil {
+		return nil, nil, unexpectedCall("NodeAPI.MirrorsList")
+	}
+	return m.MirrorsListFunc(ctx)
+}
+
+func (m *MockNodeAPI) NodeDetails(ctx context.Context) (models.NodeDetailsModel, *http.Response, error) {
+	m.record("NodeDetails")
+	if m.NodeDetailsFunc == nil {
+		return models.NodeDetailsModel{}, nil, unexpectedCall("NodeAPI.NodeDetails")
+	}
+	return m.NodeDetailsFunc(ctx)
+}
+
+func (m *MockNodeAPI) PeersList(ctx context.Context) ([]models.PeerModel, *http.Response, error) {
+	m.record("PeersList")
+	if m.PeersListFunc == nil {
+		return nil, nil, unexpectedCall("NodeAPI.PeersList")
+	}
+	return m.PeersListFunc(ctx)
+}
+
+/*
+Recording mock of client.RecordAPI. Each call is recorded and forwarded to the
+function field with the same name as the method followed by "Func". If that
+field is nil, the call returns zero values and ErrUnexpectedCall.
+*/
+type MockRecordAPI struct {
+	Recorder
+	RecordAddFunc            func(ctx context.Context, chain string, record *models.NewRecordModel) (models.RecordModel, *http.Response, error)
+	RecordAddAsJsonFunc      func(ctx context.Context, chain string, options *client.RecordApiRecordAddAsJsonOpts, jsonPayload interface{}) (models.RecordModel, *http.Response, error)
+	RecordGetFunc            func(ctx context.Context, chain string, serial int64) (models.RecordModel, *http.Response, error)
+	RecordGetAsJsonFunc      func(ctx context.Context, chain string, serial int64) (models.RecordModelAsJson, *http.Response, error)
+	GetByReferenceFunc       func(ctx context.Context, ref models.UniversalRecordReference) (models.RecordModel, *http.Response, error)
+	GetAsJsonByReferenceFunc func(ctx context.Context, ref models.UniversalRecordReference) (models.RecordModelAsJson, *http.Response, error)
+	RecordsListFunc          func(ctx context.Context, chain string, options *client.RecordApiRecordsListOpts) (models.RecordModelPageOf, *http.Response, error)
+	RecordsListAsJsonFunc    func(ctx context.Context, chain string, options *client.RecordApiRecordsListAsJsonOpts) (models.RecordModelAsJsonPageOf, *http.Response, error)
+	RecordsQueryFunc         func(ctx context.Context, chain string, options *client.RecordApiRecordsQueryOpts) (models.RecordModelPageOf, *http.Response, error)
+	RecordsQueryAsJsonFunc   func(ctx context.Context, chain string, options *client.RecordApiRecordsQueryAsJsonOpts) (models.RecordModelAsJsonPageOf, *http.Response, error)
+	FollowFunc               func(ctx context.Context, chain string, fromSerial int64, options *client.RecordApiFollowOpts) <-chan client.RecordEvent
+	ExportChainFunc          func(ctx context.Context, chain string, w io.Writer, options *client.RecordApiExportOpts) (int64, error)
+	ExportChainToFileFunc    func(ctx context.Context, chain string, file string, options *client.RecordApiExportOpts) (int64, error)
+}
+
+func (m *MockRecordAPI) RecordAdd(ctx context.Context, chain string, record *models.NewRecordModel) (models.RecordModel, *http.Response, error) {
+	m.record("RecordAdd", chain, record)
+	if m.RecordAddFunc == nil {
+		return models.RecordModel{}, nil, unexpectedCall("RecordAPI.RecordAdd")
+	}
+	return m.RecordAddFunc(ctx, chain, record)
+}
+
+func (m *MockRecordAPI) RecordAddAsJson(ctx context.Context, chain string, options *client.RecordApiRecordAddAsJsonOpts, jsonPayload interface{}) (models.RecordModel, *http.Response, error) {
+	m.record("RecordAddAsJson", chain, options, jsonPayload)
+	if m.RecordAddAsJsonFunc == nil {
+		return models.RecordModel{}, nil, unexpectedCall("RecordAPI.RecordAddAsJson")
+	}
+	return m.RecordAddAsJsonFunc(ctx, chain, options, jsonPayload)
+}
+
+func (m *MockRecordAPI) RecordGet(ctx context.Context, chain string, serial int64) (models.RecordModel, *http.Response, error) {
+	m.record("RecordGet", chain, serial)
+	if m.RecordGetFunc == nil {
+		return models.RecordModel{}, nil, unexpectedCall("RecordAPI.RecordGet")
+	}
+	return m.RecordGetFunc(ctx, chain, serial)
+}
+
+func (m *MockRecordAPI) RecordGetAsJson(ctx context.Context, chain string, serial int64) (models.RecordModelAsJson, *http.Response, error) {
+	m.record("RecordGetAsJson", chain, serial)
+	if m.RecordGetAsJsonFunc == nil {
+		return models.RecordModelAsJson{}, nil, unexpectedCall("RecordAPI.RecordGetAsJson")
+	}
+	return m.RecordGetAsJsonFunc(ctx, chain, serial)
+}
+
+func (m *MockRecordAPI) GetByReference(ctx context.Context, ref models.UniversalRecordReference) (models.RecordModel, *http.Response, error) {
+	m.record("GetByReference", ref)
+	if m.GetByReferenceFunc == nil {
+		return models.RecordModel{}, nil, unexpectedCall("RecordAPI.GetByReference")
+	}
+	return m.GetByReferenceFunc(ctx, ref)
+}
+
+func (m *MockRecordAPI) GetAsJsonByReference(ctx context.Context, ref models.UniversalRecordReference) (models.RecordModelAsJson, *http.Response, error) {
+	m.record("GetAsJsonByReference", ref)
+	if m.GetAsJsonByReferenceFunc == nil {
+		return models.RecordModelAsJson{}, nil, unexpectedCall("RecordAPI.GetAsJsonByReference")
+	}
+	return m.GetAsJsonByReferenceFunc(ctx, ref)
+}
+
+func (m *MockRecordAPI) RecordsList(ctx context.Context, chain string, options *client.RecordApiRecordsListOpts) (models.RecordModelPageOf, *http.Response, error) {
+	m.record("RecordsList", chain, options)
+	if m.RecordsListFunc == nil {
+		return models.RecordModelPageOf{}, nil, unexpectedCall("RecordAPI.RecordsList")
+	}
+	return m.RecordsListFunc(ctx, chain, options)
+}
+
+func (m *MockRecordAPI) RecordsListAsJson(ctx context.Context, chain string, options *client.RecordApiRecordsListAsJsonOpts) (models.RecordModelAsJsonPageOf, *http.Response, error) {
+	m.record("RecordsListAsJson", chain, options)
+	if m.RecordsListAsJsonFunc == nil {
+		return models.RecordModelAsJsonPageOf{}, nil, unexpectedCall("RecordAPI.RecordsListAsJson")
+	}
+	return m.RecordsListAsJsonFunc(ctx, chain, options)
+}
+
+func (m *MockRecordAPI) RecordsQuery(ctx context.Context, chain string, options *client.RecordApiRecordsQueryOpts) (models.RecordModelPageOf, *http.Response, error) {
+	m.record("RecordsQuery", chain, options)
+	if m.RecordsQueryFunc == nil {
+		return models.RecordModelPageOf{}, nil, unexpectedCall("RecordAPI.RecordsQuery")
+	}
+	return m.RecordsQueryFunc(ctx, chain, options)
+}
+
+func (m *MockRecordAPI) RecordsQueryAsJson(ctx context.Context, chain string, options *client.RecordApiRecordsQueryAsJsonOpts) (models.RecordModelAsJsonPageOf, *http.Response, error) {
+	m.record("RecordsQueryAsJson", chain, options)
+	if m.RecordsQueryAsJsonFunc == nil {
+		return models.RecordModelAsJsonPageOf{}, nil, unexpectedCall("RecordAPI.RecordsQueryAsJson")
+	}
+	return m.RecordsQueryAsJsonFunc(ctx, chain, options)
+}
+
+func (m *MockRecordAPI) Follow(ctx context.Context, chain string, fromSerial int64, options *client.RecordApiFollowOpts) <-chan client.RecordEvent {
+	m.record("Follow", chain, fromSerial, options)
+	if m.FollowFunc == nil {
+		ch := make(chan client.RecordEvent, 1)
+		ch <- client.RecordEvent{Err: unexpectedCall("RecordAPI.Follow")}
+		close(ch)
+		return ch
+	}
+	return m.FollowFunc(ctx, chain, fromSerial, options)
+}
+
+func (m *MockRecordAPI) ExportChain(ctx context.Context, chain string, w io.Writer, options *client.RecordApiExportOpts) (int64, error) {
+	m.record("ExportChain", chain, w, options)
+	if m.ExportChainFunc == nil {
+		return 0, unexpectedCall("RecordAPI.ExportChain")
+	}
+	return m.ExportChainFunc(ctx, chain, w, options)
+}
+
+func (m *MockRecordAPI) ExportChainToFile(ctx context.Context, chain string, file string, options *client.RecordApiExportOpts) (int64, error) {
+	m.record("ExportChainToFile", chain, file, options)
+	if m.ExportChainToFileFunc == nil {
+		return 0, unexpectedCall("RecordAPI.ExportChainToFile")
+	}
+	return m.ExportChainToFileFunc(ctx, chain, file, options)
+}
+
+/*
+Recording mock of client.OpaqueAPI. Each call is recorded and forwarded to the
+function field with the same name as the method followed by "Func". If that
+field is nil, the call returns zero values and ErrUnexpectedCall.
+*/
+type MockOpaqueAPI struct {
+	Recorder
+	CreateFunc    func(ctx context.Context, chain string, appId int64, payloadType int64, payload io.Reader, lastChangedRecordSerial int64) (models.OpaqueRecordModel, *http.Response, error)
+	GetFunc       func(ctx context.Context, chain string, serial int64) ([]byte, int64, int64, *http.Response, error)
+	QueryJsonFunc func(ctx context.Context, chain string, appId int64, payloadTypeIds []int64, howMany int64, lastToFirst bool, page int, pageSize int) (models.PageOfOpaqueRecordsModel, *http.Response, error)
+}
+
+func (m *MockOpaqueAPI) Create(ctx context.Context, chain string, appId int64, payloadType int64, payload io.Reader, lastChangedRecordSerial int64) (models.OpaqueRecordModel, *http.Response, error) {
+	m.record("Create", chain, appId, payloadType, payload, lastChangedRecordSerial)
+	if m.CreateFunc == nil {
+		return models.OpaqueRecordModel{}, nil, unexpectedCall("OpaqueAPI.Create")
+	}
+	return m.CreateFunc(ctx, chain, appId, payloadType, payload, lastChangedRecordSerial)
+}
+
+func (m *MockOpaqueAPI) Get(ctx context.Context, chain string, serial int64) ([]byte, int64, int64, *http.Response, error) {
+	m.record("Get", chain, serial)
+	if m.GetFunc == nil {
+		return nil, 0, 0, nil, unexpectedCall("OpaqueAPI.Get")
+	}
+	return m.GetFunc(ctx, chain, serial)
+}
+
+func (m *MockOpaqueAPI) QueryJson(ctx context.Context, chain string, appId int64, payloadTypeIds []int64, howMany int64, lastToFirst bool, page int, pageSize int) (models.PageOfOpaqueRecordsModel, *http.Response, error) {
+	m.record("QueryJson", chain, appId, payloadTypeIds, howMany, lastToFirst, page, pageSize)
+	if m.QueryJsonFunc == nil {
+		return models.PageOfOpaqueRecordsModel{}, nil, unexpectedCall("OpaqueAPI.QueryJson")
+	}
+	return m.QueryJsonFunc(ctx, chain, appId, payloadTypeIds, howMany, lastToFirst, page, pageSize)
+}
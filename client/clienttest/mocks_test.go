@@ -0,0 +1,116 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package clienttest
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/interlockledger/go-interlockledger-rest-client/client"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	var r Recorder
+
+	assert.Empty(t, r.Calls())
+	r.record("A", 1)
+	r.record("B", "x", 2)
+	r.record("A", 3)
+	assert.Equal(t, []Call{{"A", []any{1}}, {"B", []any{"x", 2}}, {"A", []any{3}}}, r.Calls())
+	assert.Equal(t, []Call{{"A", []any{1}}, {"A", []any{3}}}, r.CallsTo("A"))
+	assert.Empty(t, r.CallsTo("C"))
+
+	calls := r.Calls()
+	calls[0].Method = "Z"
+	assert.Equal(t, "A", r.Calls()[0].Method)
+
+	r.Reset()
+	assert.Empty(t, r.Calls())
+}
+
+func TestMockClient(t *testing.T) {
+	m := &MockClient{}
+	var c client.Client = m
+	ctx := context.Background()
+
+	m.RecordAPI.RecordGetFunc = func(ctx context.Context, chain string, serial int64) (models.RecordModel, *http.Response, error) {
+		return models.RecordModel{ChainId: chain, Serial: serial}, nil, nil
+	}
+	rec, _, err := c.Record().RecordGet(ctx, "chain", 10)
+	require.Nil(t, err)
+	assert.Equal(t, "chain", rec.ChainId)
+	assert.Equal(t, int64(10), rec.Serial)
+	assert.Equal(t, []Call{{"RecordGet", []any{"chain", int64(10)}}}, m.RecordAPI.Calls())
+
+	// Not configured
+	_, _, err = c.Chain().ChainDetails(ctx, "chain")
+	assert.ErrorIs(t, err, ErrUnexpectedCall)
+	assert.ErrorContains(t, err, "ChainAPI.ChainDetails")
+	assert.Len(t, m.ChainAPI.CallsTo("ChainDetails"), 1)
+
+	_, _, _, _, err = c.Opaque().Get(ctx, "chain", 1)
+	assert.ErrorIs(t, err, ErrUnexpectedCall)
+	_, _, err = c.Node().NodeDetails(ctx)
+	assert.ErrorIs(t, err, ErrUnexpectedCall)
+	_, _, err = c.JsonDocument().JsonDocumentsGet(ctx, "chain", 1)
+	assert.ErrorIs(t, err, ErrUnexpectedCall)
+	_, err = c.Documents().DocumentsGetAllDocuments(ctx, "locator")
+	assert.ErrorIs(t, err, ErrUnexpectedCall)
+
+	_, err = c.Do(ctx, "GET", "/x", nil, nil, nil)
+	assert.ErrorIs(t, err, ErrUnexpectedCall)
+	m.DoFunc = func(ctx context.Context, method, path string, query url.Values, body any, out any) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusNoContent}, nil
+	}
+	payload := []byte("body")
+	resp, err := c.Do(ctx, "POST", "/x", nil, payload, nil)
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	require.Len(t, m.CallsTo("Do"), 2)
+	assert.Equal(t, []any{"POST", "/x", url.Values(nil), payload, nil}, m.CallsTo("Do")[1].Args)
+
+	// Opaque payloads are recorded as given
+	m.OpaqueAPI.CreateFunc = func(ctx context.Context, chain string, appId, payloadType int64, payload io.Reader, lastChanged int64) (models.OpaqueRecordModel, *http.Response, error) {
+		return models.OpaqueRecordModel{Serial: 1}, nil, nil
+	}
+	r := bytes.NewReader(payload)
+	op, _, err := c.Opaque().Create(ctx, "chain", 1, 2, r, 0)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), op.Serial)
+	assert.Equal(t, []any{"chain", int64(1), int64(2), r, int64(0)}, m.OpaqueAPI.CallsTo("Create")[0].Args)
+}
@@ -0,0 +1,467 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package clienttest
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/interlockledger/go-iltags/ilint"
+	"github.com/interlockledger/go-interlockledger-rest-client/client"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+)
+
+const (
+	// Default name of the network of the fake node.
+	DefaultNetwork = "FakeNet"
+	// Version returned by GET /apiVersion.
+	APIVersion = "7.2.0"
+	// Application id of the JSON documents.
+	JSONDocumentsAppId = 8
+	// Application id of the multi-document storage.
+	DocumentsAppId = 9
+	// Default page size used when the request does not specify one.
+	DefaultPageSize = 10
+)
+
+/*
+Options of the fake node.
+*/
+type Options struct {
+	// Name of the network. If empty, DefaultNetwork is used.
+	Network string
+	// If true, the node will use HTTPS with a generated certificate.
+	TLS bool
+	// If true, the node will require a client certificate. It implies TLS.
+	MutualTLS bool
+	// Function used to get the current time. If nil, time.Now is used.
+	Now func() time.Time
+}
+
+/*
+Hook called before each request is handled. If it returns a status code other
+than zero, the request fails with that status. The operation is the same
+operation ID used by the client, e.g. "Record_Get".
+*/
+type ErrorHook func(operation string, request *http.Request) int
+
+// A record of the fake node.
+type record struct {
+	model models.RecordModel
+	// The JSON payload, if the record was added as JSON.
+	json any
+	// True if the record was created by the opaque API.
+	opaque bool
+}
+
+// A chain of the fake node.
+type chain struct {
+	summary       models.ChainSummaryModel
+	records       []*record
+	keys          []models.KeyDetailsModel
+	interlockings []models.InterlockingRecordModel
+	jsonDocuments map[int64]models.JsonDocumentModel
+}
+
+// A document uploaded in a documents transaction.
+type document struct {
+	entry models.DirectoryEntry
+	data  []byte
+}
+
+// A documents transaction or a committed set of documents.
+type documentsTransaction struct {
+	model     models.DocumentsTransactionModel
+	begin     models.DocumentsBeginTransactionModel
+	documents []document
+}
+
+// Failure scheduled by FailNext().
+type scheduledFailure struct {
+	operation string
+	status    int
+	count     int
+}
+
+/*
+Fake InterlockLedger node. All methods are safe for concurrent use.
+*/
+type Node struct {
+	// The underlying server.
+	Server *httptest.Server
+
+	mutex        sync.Mutex
+	network      string
+	now          func() time.Time
+	id           string
+	chains       map[string]*chain
+	chainOrder   []string
+	mirrors      []string
+	apps         []models.IInterlockAppTraits
+	peers        []models.PeerModel
+	transactions map[string]*documentsTransaction
+	documents    map[string]*documentsTransaction
+	calls        map[string]int
+	failures     []*scheduledFailure
+	errorHook    ErrorHook
+	clientCert   *tls.Certificate
+	serverCert   *tls.Certificate
+}
+
+/*
+Creates and starts a new fake node. If opts is nil, the default options are
+used. It panics if the node cannot be started, like httptest.NewServer().
+*/
+func NewNode(opts *Options) *Node {
+	if opts == nil {
+		opts = &Options{}
+	}
+	n := &Node{
+		network:      opts.Network,
+		now:          opts.Now,
+		id:           randomId(),
+		chains:       make(map[string]*chain),
+		transactions: make(map[string]*documentsTransaction),
+		documents:    make(map[string]*documentsTransaction),
+		calls:        make(map[string]int),
+	}
+	if n.network == "" {
+		n.network = DefaultNetwork
+	}
+	if n.now == nil {
+		n.now = time.Now
+	}
+	n.Server = httptest.NewUnstartedServer(http.HandlerFunc(n.serveHTTP))
+	if opts.TLS || opts.MutualTLS {
+		serverCert, err := generateCertificate("fake-node", true)
+		if err != nil {
+			panic(fmt.Sprintf("clienttest: unable to create the server certificate: %v", err))
+		}
+		clientCert, err := generateCertificate("fake-client", false)
+		if err != nil {
+			panic(fmt.Sprintf("clienttest: unable to create the client certificate: %v", err))
+		}
+		n.serverCert = &serverCert
+		n.clientCert = &clientCert
+		n.Server.TLS = &tls.Config{Certificates: []tls.Certificate{serverCert}}
+		if opts.MutualTLS {
+			pool := x509.NewCertPool()
+			pool.AddCert(clientCert.Leaf)
+			n.Server.TLS.ClientAuth = tls.RequireAndVerifyClientCert
+			n.Server.TLS.ClientCAs = pool
+		}
+		n.Server.StartTLS()
+	} else {
+		n.Server.Start()
+	}
+	return n
+}
+
+// Shuts down the node.
+func (n *Node) Close() {
+	n.Server.Close()
+}
+
+// Returns the base URL of the node.
+func (n *Node) URL() string {
+	return n.Server.URL
+}
+
+// Returns the name of the network of the node.
+func (n *Node) Network() string {
+	return n.network
+}
+
+/*
+Returns the certificate of the server or nil if the node does not use TLS.
+*/
+func (n *Node) ServerCertificate() *x509.Certificate {
+	if n.serverCert == nil {
+		return nil
+	}
+	return n.serverCert.Leaf
+}
+
+/*
+Returns the client certificate accepted by the node or nil if the node does not
+use TLS. The certificate is always generated when TLS is enabled, even if it
+is not required.
+*/
+func (n *Node) ClientCertificate() *tls.Certificate {
+	return n.clientCert
+}
+
+/*
+Writes the client certificate and its key as PEM files named client.pem and
+client.key inside dir. It fails if the node does not use TLS.
+*/
+func (n *Node) WriteClientCertificate(dir string) (certFile string, keyFile string, err error) {
+	if n.clientCert == nil {
+		return "", "", fmt.Errorf("the node does not use TLS")
+	}
+	certFile = filepath.Join(dir, "client.pem")
+	keyFile = filepath.Join(dir, "client.key")
+	if err := writeCertificate(*n.clientCert, certFile, keyFile); err != nil {
+		return "", "", err
+	}
+	return certFile, keyFile, nil
+}
+
+/*
+Creates a new client configuration ready to access this node. If the node uses
+TLS, the configuration trusts the server certificate and uses the generated
+client certificate.
+*/
+func (n *Node) NewConfiguration() *client.Configuration {
+	cfg := client.NewConfiguration()
+	cfg.BasePath = n.URL()
+	if n.serverCert == nil {
+		cfg.HTTPClient = n.Server.Client()
+		return cfg
+	}
+	cfg.CertPool = x509.NewCertPool()
+	cfg.CertPool.AddCert(n.serverCert.Leaf)
+	cfg.ClientCertificates = []tls.Certificate{*n.clientCert}
+	cfg.DisableProxy = true
+	if err := cfg.Init(); err != nil {
+		panic(fmt.Sprintf("clienttest: unable to initialize the configuration: %v", err))
+	}
+	return cfg
+}
+
+// Creates a new client for this node using NewConfiguration().
+func (n *Node) NewClient() *client.APIClient {
+	return client.NewAPIClient(n.NewConfiguration())
+}
+
+/*
+Sets the hook used to inject errors. Set it to nil to remove it.
+*/
+func (n *Node) SetErrorHook(hook ErrorHook) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.errorHook = hook
+}
+
+/*
+Makes the next count calls to the given operation fail with the given status
+code. If operation is empty, any operation will fail. Failures scheduled
+first are consumed first.
+*/
+func (n *Node) FailNext(operation string, status int, count int) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.failures = append(n.failures, &scheduledFailure{operation, status, count})
+}
+
+/*
+Returns the number of calls received by the given operation, including the
+failed ones.
+*/
+func (n *Node) Calls(operation string) int {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.calls[operation]
+}
+
+/*
+Adds an app to the list returned by GET /apps.
+*/
+func (n *Node) AddApp(app models.IInterlockAppTraits) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.apps = append(n.apps, app)
+}
+
+/*
+Adds a peer to the list returned by GET /peers.
+*/
+func (n *Node) AddPeer(peer models.PeerModel) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.peers = append(n.peers, peer)
+}
+
+/*
+Creates a new chain with its root record (serial 0) and returns its id.
+*/
+func (n *Node) CreateChain(name string) string {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.createChain(name, "", nil).summary.Id
+}
+
+/*
+Returns the summary of the chain.
+*/
+func (n *Node) Chain(id string) (models.ChainSummaryModel, bool) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	c, ok := n.chains[id]
+	if !ok {
+		return models.ChainSummaryModel{}, false
+	}
+	return c.summary, true
+}
+
+/*
+Adds a new data record with the given payload to the chain. The payload must
+be a serialized ILTag. It fails if the chain does not exist.
+*/
+func (n *Node) AddRecord(chainId string, applicationId int64, payload []byte) (models.RecordModel, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	c, ok := n.chains[chainId]
+	if !ok {
+		return models.RecordModel{}, fmt.Errorf("chain %s not found", chainId)
+	}
+	return n.appendRecord(c, applicationId, payloadTagId(payload), payload,
+		models.DATA_RecordType).model, nil
+}
+
+/*
+Adds a new data record with the given JSON payload to the chain. It fails if
+the chain does not exist.
+*/
+func (n *Node) AddJSONRecord(chainId string, applicationId int64, payloadTagId int64, payload any) (models.RecordModel, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	c, ok := n.chains[chainId]
+	if !ok {
+		return models.RecordModel{}, fmt.Errorf("chain %s not found", chainId)
+	}
+	return n.appendJSONRecord(c, applicationId, payloadTagId, payload,
+		models.DATA_RecordType).model, nil
+}
+
+/*
+Returns a copy of the records of the chain.
+*/
+func (n *Node) Records(chainId string) []models.RecordModel {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	c, ok := n.chains[chainId]
+	if !ok {
+		return nil
+	}
+	ret := make([]models.RecordModel, len(c.records))
+	for i, r := range c.records {
+		ret[i] = r.model
+	}
+	return ret
+}
+
+// Creates a new chain. Must be called with the lock held.
+func (n *Node) createChain(name, description string, apps []int64) *chain {
+	c := &chain{
+		summary: models.ChainSummaryModel{
+			Id:          randomId(),
+			Name:        name,
+			Description: description,
+			ActiveApps:  apps,
+		},
+		jsonDocuments: make(map[int64]models.JsonDocumentModel),
+	}
+	n.chains[c.summary.Id] = c
+	n.chainOrder = append(n.chainOrder, c.summary.Id)
+	n.appendRecord(c, 0, 0, nil, models.ROOT_RecordType)
+	return c
+}
+
+// Appends a new record to the chain. Must be called with the lock held.
+func (n *Node) appendRecord(c *chain, applicationId, payloadTagId int64, payload []byte,
+	recordType models.RecordType) *record {
+	serial := int64(len(c.records))
+	h := sha256.New()
+	fmt.Fprintf(h, "%s@%d:", c.summary.Id, serial)
+	h.Write(payload)
+	r := &record{
+		model: models.RecordModel{
+			ApplicationId: applicationId,
+			ChainId:       c.summary.Id,
+			CreatedAt:     n.now().UTC(),
+			Hash:          base64.RawURLEncoding.EncodeToString(h.Sum(nil)) + "#SHA256",
+			Network:       n.network,
+			PayloadTagId:  payloadTagId,
+			Reference:     fmt.Sprintf("%s:%s@%d", n.network, c.summary.Id, serial),
+			Serial:        serial,
+			Type_:         &recordType,
+			Version:       1,
+			PayloadBytes:  models.EncodeBytes(payload),
+		},
+	}
+	c.records = append(c.records, r)
+	c.summary.LastRecord = serial
+	c.summary.LastUpdate = r.model.CreatedAt
+	c.summary.SizeInBytes += int64(len(payload))
+	return r
+}
+
+/*
+Appends a new record with a JSON payload to the chain. As the fake node does
+not know the data models, PayloadBytes will hold the JSON text. Must be called
+with the lock held.
+*/
+func (n *Node) appendJSONRecord(c *chain, applicationId, payloadTagId int64, payload any,
+	recordType models.RecordType) *record {
+	text, _ := json.Marshal(payload)
+	r := n.appendRecord(c, applicationId, payloadTagId, text, recordType)
+	r.json = payload
+	return r
+}
+
+// Returns the tag id of the serialized ILTag or zero if it is invalid.
+func payloadTagId(payload []byte) int64 {
+	id, _, err := ilint.Decode(payload)
+	if err != nil {
+		return 0
+	}
+	return int64(id)
+}
+
+// Creates a new random id in the same format of the chain ids.
+func randomId() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
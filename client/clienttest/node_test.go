@@ -0,0 +1,235 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package clienttest
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/antihax/optional"
+	"github.com/interlockledger/go-interlockledger-rest-client/client"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNode(t *testing.T) {
+	now := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	node := NewNode(&Options{Network: "Test", Now: func() time.Time { return now }})
+	defer node.Close()
+
+	assert.Equal(t, "Test", node.Network())
+	assert.Nil(t, node.ServerCertificate())
+	assert.Nil(t, node.ClientCertificate())
+	_, _, err := node.WriteClientCertificate(t.TempDir())
+	assert.Error(t, err)
+
+	chain := node.CreateChain("chain")
+	summary, ok := node.Chain(chain)
+	require.True(t, ok)
+	assert.Equal(t, "chain", summary.Name)
+	assert.Equal(t, int64(0), summary.LastRecord)
+	assert.Equal(t, now, summary.LastUpdate)
+
+	// ILTag with tag id 300 (ILInt 0xF8 0x34)
+	rec, err := node.AddRecord(chain, 1, []byte{0xF8, 0x34, 0x00})
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), rec.Serial)
+	assert.Equal(t, int64(300), rec.PayloadTagId)
+	assert.Equal(t, "Test:"+chain+"@1", rec.Reference)
+
+	rec, err = node.AddJSONRecord(chain, 1, 300, map[string]any{"a": 1})
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), rec.Serial)
+	assert.Len(t, node.Records(chain), 3)
+
+	_, err = node.AddRecord("unknown", 1, nil)
+	assert.Error(t, err)
+	_, err = node.AddJSONRecord("unknown", 1, 1, nil)
+	assert.Error(t, err)
+	_, ok = node.Chain("unknown")
+	assert.False(t, ok)
+	assert.Nil(t, node.Records("unknown"))
+}
+
+func TestNode_MutualTLS(t *testing.T) {
+	node := NewNode(&Options{MutualTLS: true})
+	defer node.Close()
+	require.NotNil(t, node.ServerCertificate())
+	require.NotNil(t, node.ClientCertificate())
+
+	c := node.NewClient()
+	version, _, err := c.NodeApi.ApiVersion(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, APIVersion, version)
+
+	// Certificate files
+	certFile, keyFile, err := node.WriteClientCertificate(t.TempDir())
+	require.Nil(t, err)
+	assert.FileExists(t, certFile)
+	assert.FileExists(t, keyFile)
+	cfg := client.NewConfiguration()
+	cfg.BasePath = node.URL()
+	cfg.CertFile = certFile
+	cfg.KeyFile = keyFile
+	cfg.CertPool = node.NewConfiguration().CertPool
+	require.Nil(t, cfg.Init())
+	_, _, err = client.NewAPIClient(cfg).NodeApi.ApiVersion(context.Background())
+	assert.Nil(t, err)
+
+	// Without the client certificate
+	cfg = client.NewConfiguration()
+	cfg.BasePath = node.URL()
+	cfg.HTTPClient = node.Server.Client()
+	cfg.RetryPolicy = nil
+	_, _, err = client.NewAPIClient(cfg).NodeApi.ApiVersion(context.Background())
+	assert.Error(t, err)
+}
+
+func TestNode_FailNext(t *testing.T) {
+	node := NewNode(nil)
+	defer node.Close()
+	chain := node.CreateChain("chain")
+	cfg := node.NewConfiguration()
+	cfg.RetryPolicy = nil
+	c := client.NewAPIClient(cfg)
+	ctx := context.Background()
+
+	node.FailNext("Record_Get", http.StatusServiceUnavailable, 2)
+	node.FailNext("", http.StatusUnprocessableEntity, 1)
+	for i := 0; i < 2; i++ {
+		_, _, err := c.RecordApi.RecordGet(ctx, chain, 0)
+		assert.ErrorIs(t, err, client.ErrServerError)
+	}
+	_, _, err := c.ChainApi.ChainDetails(ctx, chain)
+	assert.ErrorIs(t, err, client.ErrValidation)
+	_, _, err = c.RecordApi.RecordGet(ctx, chain, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, node.Calls("Record_Get"))
+	assert.Equal(t, 1, node.Calls("Chain_Details"))
+}
+
+func TestNode_SetErrorHook(t *testing.T) {
+	node := NewNode(nil)
+	defer node.Close()
+	chain := node.CreateChain("chain")
+	c := node.NewClient()
+	ctx := context.Background()
+
+	node.SetErrorHook(func(operation string, r *http.Request) int {
+		if operation == "Opaque_Create" {
+			return http.StatusConflict
+		}
+		return 0
+	})
+	_, _, err := c.OpaqueApi.Create(ctx, chain, 1, 2, bytes.NewReader([]byte{1}), 0)
+	assert.ErrorIs(t, err, client.ErrOptimisticLockError)
+	_, _, err = c.ChainApi.ChainDetails(ctx, chain)
+	assert.Nil(t, err)
+
+	node.SetErrorHook(nil)
+	_, _, err = c.OpaqueApi.Create(ctx, chain, 1, 2, bytes.NewReader([]byte{1}), 0)
+	assert.Nil(t, err)
+}
+
+func TestNode_Paging(t *testing.T) {
+	node := NewNode(nil)
+	defer node.Close()
+	chain := node.CreateChain("chain")
+	for i := 0; i < 24; i++ {
+		_, err := node.AddJSONRecord(chain, 1, 300, i)
+		require.Nil(t, err)
+	}
+	c := node.NewClient()
+	ctx := context.Background()
+
+	page, _, err := c.RecordApi.RecordsList(ctx, chain, nil)
+	require.Nil(t, err)
+	assert.Len(t, page.Items, DefaultPageSize)
+	assert.Equal(t, int32(3), page.TotalNumberOfPages)
+
+	page, _, err = c.RecordApi.RecordsList(ctx, chain, &client.RecordApiRecordsListOpts{
+		RecordApiPagingOpts: client.RecordApiPagingOpts{
+			Page:        optional.NewInt32(1),
+			PageSize:    optional.NewInt32(5),
+			LastToFirst: optional.NewBool(true),
+		},
+		FirstSerial: optional.NewInt64(3),
+		LastSerial:  optional.NewInt64(20),
+	})
+	require.Nil(t, err)
+	require.Len(t, page.Items, 5)
+	assert.Equal(t, int64(15), page.Items[0].Serial)
+	assert.Equal(t, int64(11), page.Items[4].Serial)
+	assert.Equal(t, int32(4), page.TotalNumberOfPages)
+	assert.True(t, page.LastToFirst)
+
+	page, _, err = c.RecordApi.RecordsList(ctx, chain, &client.RecordApiRecordsListOpts{
+		RecordApiPagingOpts: client.RecordApiPagingOpts{Page: optional.NewInt32(10)},
+	})
+	require.Nil(t, err)
+	assert.Empty(t, page.Items)
+}
+
+func TestNode_Errors(t *testing.T) {
+	node := NewNode(nil)
+	defer node.Close()
+	chain := node.CreateChain("chain")
+	c := node.NewClient()
+	ctx := context.Background()
+
+	_, _, err := c.ChainApi.ChainDetails(ctx, "unknown")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+	_, _, err = c.RecordApi.RecordGet(ctx, chain, 1)
+	assert.ErrorIs(t, err, client.ErrNotFound)
+	_, _, err = c.JsonDocumentApi.JsonDocumentsGet(ctx, chain, 0)
+	assert.ErrorIs(t, err, client.ErrNotFound)
+	_, _, _, _, err = c.OpaqueApi.Get(ctx, chain, 0)
+	assert.ErrorIs(t, err, client.ErrNotFound)
+	_, _, err = c.RecordApi.RecordAdd(ctx, chain, &models.NewRecordModel{ApplicationId: 1})
+	assert.ErrorIs(t, err, client.ErrValidation)
+	_, _, err = c.ChainApi.ChainCreate(ctx, &models.ChainCreatedModel{})
+	assert.ErrorIs(t, err, client.ErrValidation)
+	_, err = c.Do(ctx, http.MethodDelete, "/chain", nil, nil, nil)
+	assert.ErrorIs(t, err, client.ErrNotFound)
+
+	// Closed chain
+	closing := models.CLOSING_RecordType
+	_, _, err = c.RecordApi.RecordAdd(ctx, chain, &models.NewRecordModel{
+		ApplicationId: 1, PayloadBytes: models.EncodeBytes([]byte{0}), Type_: &closing})
+	require.Nil(t, err)
+	_, _, err = c.RecordApi.RecordAdd(ctx, chain, &models.NewRecordModel{
+		ApplicationId: 1, PayloadBytes: models.EncodeBytes([]byte{0})})
+	assert.ErrorIs(t, err, client.ErrValidation)
+}
@@ -0,0 +1,58 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+This package implements a fake InterlockLedger node that can be used to test
+code built on top of this client library without a real node.
+
+The fake node is an httptest.Server that keeps its state in memory and
+implements the routes used by the client library: node, apps, chains,
+records (binary and JSON), queries, JSON documents, documents transactions,
+opaque records and interlockings. It can also require client certificates
+(mTLS) using certificates generated on the fly and inject errors into any
+operation.
+
+Example:
+
+	node := clienttest.NewNode(nil)
+	defer node.Close()
+	chain := node.CreateChain("test")
+	c := node.NewClient()
+	rec, _, err := c.RecordApi.RecordGet(context.Background(), chain, 0)
+
+The fake node does not validate signatures, keys or InterlockQL queries and
+does not encode payloads as ILTags.
+
+For unit tests that do not need an HTTP server at all, this package also
+provides recording mocks of the interfaces client.Client, client.ChainAPI,
+client.RecordAPI, etc. Code that depends on client.Client can receive a
+*MockClient in tests and an *client.APIClient in production.
+*/
+package clienttest
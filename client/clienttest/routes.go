@@ -0,0 +1,1164 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package clienttest
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+)
+
+// Handler of a route. It is always called with the lock of the node held.
+type routeHandler func(w http.ResponseWriter, r *http.Request, body []byte)
+
+// Content types accepted by the documents transactions.
+var permittedContentTypes = []string{
+	"application/json",
+	"application/octet-stream",
+	"application/pdf",
+	"image/jpeg",
+	"image/png",
+	"text/plain",
+}
+
+// Maximum size of each document.
+const documentSizeLimit = 16 * 1024 * 1024
+
+// Handles all requests of the node.
+func (n *Node) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeProblem(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	operation, handler := n.route(r)
+	if handler == nil {
+		writeProblem(w, http.StatusNotFound, fmt.Sprintf("no route for %s %s", r.Method, r.URL.Path))
+		return
+	}
+
+	n.mutex.Lock()
+	n.calls[operation]++
+	status := n.scheduledFailure(operation)
+	hook := n.errorHook
+	n.mutex.Unlock()
+	if status == 0 && hook != nil {
+		status = hook(operation, r)
+	}
+	if status != 0 {
+		writeProblem(w, status, fmt.Sprintf("injected failure of %s", operation))
+		return
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	handler(w, r, body)
+}
+
+// Consumes the next scheduled failure of the operation. Must be called with the lock held.
+func (n *Node) scheduledFailure(operation string) int {
+	for i, f := range n.failures {
+		if f.operation == "" || f.operation == operation {
+			f.count--
+			if f.count <= 0 {
+				n.failures = append(n.failures[:i], n.failures[i+1:]...)
+			}
+			return f.status
+		}
+	}
+	return 0
+}
+
+// Selects the handler of the request. It returns a nil handler if there is no route.
+func (n *Node) route(r *http.Request) (string, routeHandler) {
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	get := r.Method == http.MethodGet
+	post := r.Method == http.MethodPost
+	first := segments[0]
+	switch {
+	case len(segments) == 1 && first == "" && get:
+		return "Node_Details", n.nodeDetails
+	case len(segments) == 1 && first == "apiVersion" && get:
+		return "ApiVersion", n.apiVersion
+	case len(segments) == 1 && first == "apps" && get:
+		return "Apps_List", n.appsList
+	case len(segments) == 1 && first == "peers" && get:
+		return "Peers_List", n.peersList
+	case len(segments) == 1 && first == "mirrors" && get:
+		return "Mirrors_List", n.mirrorsList
+	case len(segments) == 1 && first == "mirrors" && post:
+		return "Mirror_Add", n.mirrorAdd
+	case len(segments) == 2 && first == "interlockings" && get:
+		return "Interlockings_List", n.withTarget(segments[1], n.interlockingsList)
+	case first == "chain":
+		return n.routeChain(segments[1:], get, post)
+	case strings.HasPrefix(first, "records@"):
+		return n.routeRecords(strings.TrimPrefix(first, "records@"), segments[1:], get, post)
+	case strings.HasPrefix(first, "jsonDocuments@"):
+		return n.routeJSONDocuments(strings.TrimPrefix(first, "jsonDocuments@"), segments[1:], get, post)
+	case first == "documents":
+		return n.routeDocuments(segments[1:], get, post)
+	case first == "opaque":
+		return n.routeOpaque(segments[1:], get, post)
+	}
+	return "", nil
+}
+
+// Routes /chain/...
+func (n *Node) routeChain(segments []string, get, post bool) (string, routeHandler) {
+	switch {
+	case len(segments) == 0 && get:
+		return "Chains_List", n.chainsList
+	case len(segments) == 0 && post:
+		return "Chain_Create", n.chainCreate
+	case len(segments) == 1 && get:
+		return "Chain_Details", n.withChain(segments[0], n.chainDetails)
+	case len(segments) != 2:
+		return "", nil
+	case segments[1] == "activeApps" && get:
+		return "Chain_ActiveApps_List", n.withChain(segments[0], n.activeAppsList)
+	case segments[1] == "activeApps" && post:
+		return "Chain_ActiveApps_Add", n.withChain(segments[0], n.activeAppsAdd)
+	case segments[1] == "key" && get:
+		return "Chain_PermittedKeys_List", n.withChain(segments[0], n.permittedKeysList)
+	case segments[1] == "key" && post:
+		return "Chain_PermittedKeys_Add", n.withChain(segments[0], n.permittedKeysAdd)
+	case segments[1] == "interlockings" && get:
+		return "Chain_Interlockings_List", n.withChain(segments[0], n.chainInterlockingsList)
+	case segments[1] == "interlockings" && post:
+		return "Chain_Interlocking_Add", n.withChain(segments[0], n.chainInterlockingAdd)
+	}
+	return "", nil
+}
+
+// Routes /records@{chain}/...
+func (n *Node) routeRecords(chainId string, segments []string, get, post bool) (string, routeHandler) {
+	asJson := len(segments) > 0 && segments[0] == "asJson"
+	if asJson {
+		segments = segments[1:]
+	}
+	suffix := ""
+	if asJson {
+		suffix = "_AsJson"
+	}
+	switch {
+	case len(segments) == 0 && get:
+		return "Records_List" + suffix, n.withChain(chainId, n.recordsList(asJson))
+	case len(segments) == 0 && post && asJson:
+		return "Record_Add_AsJson", n.withChain(chainId, n.recordAddAsJson)
+	case len(segments) == 0 && post:
+		return "Record_Add", n.withChain(chainId, n.recordAdd)
+	case len(segments) == 1 && segments[0] == "query" && get:
+		return "Records_Query" + suffix, n.withChain(chainId, n.recordsQuery(asJson))
+	case len(segments) == 1 && get:
+		return "Record_Get" + suffix, n.withRecord(chainId, segments[0], n.recordGet(asJson))
+	}
+	return "", nil
+}
+
+// Routes /jsonDocuments@{chain}/...
+func (n *Node) routeJSONDocuments(chainId string, segments []string, get, post bool) (string, routeHandler) {
+	switch {
+	case len(segments) == 0 && post:
+		return "JsonDocuments_Add", n.withChain(chainId, n.jsonDocumentsAdd)
+	case len(segments) != 1:
+		return "", nil
+	case segments[0] == "withChainKeys" && post:
+		return "JsonDocuments_Add_WithChainKeys", n.withChain(chainId, n.jsonDocumentsAdd)
+	case segments[0] == "withIndirectKeys" && post:
+		return "JsonDocuments_Add_WithIndirectKeys", n.withChain(chainId, n.jsonDocumentsAdd)
+	case segments[0] == "withKey" && post:
+		return "JsonDocuments_Add_WithKey", n.withChain(chainId, n.jsonDocumentsAdd)
+	case segments[0] == "allow" && post:
+		return "JsonDocuments_AllowReaders", n.withChain(chainId, n.jsonDocumentsAllowReaders)
+	case get:
+		return "JsonDocuments_Get", n.withRecord(chainId, segments[0], n.jsonDocumentsGet)
+	}
+	return "", nil
+}
+
+// Routes /documents/...
+func (n *Node) routeDocuments(segments []string, get, post bool) (string, routeHandler) {
+	switch {
+	case len(segments) == 1 && segments[0] == "configuration" && get:
+		return "Documents_Get_Config", n.documentsGetConfig
+	case len(segments) == 1 && segments[0] == "transaction" && post:
+		return "Documents_Begin_Transaction", n.documentsBeginTransaction
+	case len(segments) == 2 && segments[0] == "transaction" && post:
+		return "Documents_Add_Document", n.withTransaction(segments[1], n.documentsAddDocument)
+	case len(segments) == 2 && segments[0] == "transaction" && get:
+		return "Documents_Get_TransactionStatus", n.withTransaction(segments[1], n.documentsGetTransactionStatus)
+	case len(segments) == 3 && segments[0] == "transaction" && segments[2] == "commit" && post:
+		return "Documents_Commit_Transaction", n.withTransaction(segments[1], n.documentsCommitTransaction)
+	case len(segments) == 2 && segments[1] == "metadata" && get:
+		return "Documents_Get_Metadata", n.withDocuments(segments[0], n.documentsGetMetadata)
+	case len(segments) == 2 && segments[1] == "zip" && get:
+		return "Documents_Get_AllDocuments", n.withDocuments(segments[0], n.documentsGetAll)
+	case len(segments) == 2 && get:
+		index := segments[1]
+		return "Documents_Get_SingleDocument", n.withDocuments(segments[0],
+			func(w http.ResponseWriter, r *http.Request, t *documentsTransaction) {
+				n.documentsGetSingle(w, t, index)
+			})
+	}
+	return "", nil
+}
+
+// Routes /opaque/...
+func (n *Node) routeOpaque(segments []string, get, post bool) (string, routeHandler) {
+	switch {
+	case len(segments) == 1 && get && strings.Contains(segments[0], "@"):
+		i := strings.LastIndex(segments[0], "@")
+		return "Opaque_Get", n.withRecord(segments[0][:i], segments[0][i+1:], n.opaqueGet)
+	case len(segments) == 1 && post:
+		return "Opaque_Create", n.withChain(segments[0], n.opaqueCreate)
+	case len(segments) == 3 && segments[1] == "asJson" && segments[2] == "query" && get:
+		return "Opaque_Query_AsJson", n.withChain(segments[0], n.opaqueQueryJson)
+	}
+	return "", nil
+}
+
+// Wraps a handler that requires an existing chain.
+func (n *Node) withChain(chainId string,
+	h func(w http.ResponseWriter, r *http.Request, body []byte, c *chain)) routeHandler {
+	return func(w http.ResponseWriter, r *http.Request, body []byte) {
+		c, ok := n.chains[chainId]
+		if !ok {
+			writeProblem(w, http.StatusNotFound, fmt.Sprintf("chain %s not found", chainId))
+			return
+		}
+		h(w, r, body, c)
+	}
+}
+
+// Wraps a handler that requires an existing target chain.
+func (n *Node) withTarget(chainId string,
+	h func(w http.ResponseWriter, r *http.Request, chainId string)) routeHandler {
+	return func(w http.ResponseWriter, r *http.Request, body []byte) {
+		h(w, r, chainId)
+	}
+}
+
+// Wraps a handler that requires an existing record.
+func (n *Node) withRecord(chainId string, serial string,
+	h func(w http.ResponseWriter, r *http.Request, c *chain, rec *record)) routeHandler {
+	return n.withChain(chainId, func(w http.ResponseWriter, r *http.Request, body []byte, c *chain) {
+		s, err := strconv.ParseInt(serial, 10, 64)
+		if err != nil {
+			writeProblem(w, http.StatusBadRequest, fmt.Sprintf("invalid serial %q", serial))
+			return
+		}
+		if s < 0 || s >= int64(len(c.records)) {
+			writeProblem(w, http.StatusNotFound, fmt.Sprintf("record %s@%d not found", chainId, s))
+			return
+		}
+		h(w, r, c, c.records[s])
+	})
+}
+
+// Wraps a handler that requires an open documents transaction.
+func (n *Node) withTransaction(transactionId string,
+	h func(w http.ResponseWriter, r *http.Request, body []byte, t *documentsTransaction)) routeHandler {
+	return func(w http.ResponseWriter, r *http.Request, body []byte) {
+		t, ok := n.transactions[transactionId]
+		if !ok {
+			writeProblem(w, http.StatusNotFound, fmt.Sprintf("transaction %s not found", transactionId))
+			return
+		}
+		h(w, r, body, t)
+	}
+}
+
+// Wraps a handler that requires committed documents.
+func (n *Node) withDocuments(locator string,
+	h func(w http.ResponseWriter, r *http.Request, t *documentsTransaction)) routeHandler {
+	return func(w http.ResponseWriter, r *http.Request, body []byte) {
+		t, ok := n.documents[locator]
+		if !ok {
+			writeProblem(w, http.StatusNotFound, fmt.Sprintf("documents %s not found", locator))
+			return
+		}
+		h(w, r, t)
+	}
+}
+
+//------------------------------------------------------------------------------
+// Node
+
+func (n *Node) nodeDetails(w http.ResponseWriter, r *http.Request, body []byte) {
+	writeJSON(w, models.NodeDetailsModel{
+		Chains:           append([]string{}, n.chainOrder...),
+		Id:               n.id,
+		Name:             "Fake Node",
+		Network:          n.network,
+		Roles:            []string{"Interlocking", "Mirror"},
+		SoftwareVersions: &models.SoftwareVersions{Main: APIVersion},
+	})
+}
+
+func (n *Node) apiVersion(w http.ResponseWriter, r *http.Request, body []byte) {
+	writeJSON(w, APIVersion)
+}
+
+func (n *Node) appsList(w http.ResponseWriter, r *http.Request, body []byte) {
+	writeJSON(w, models.AppsModel{
+		Network:   n.network,
+		ValidApps: append([]models.IInterlockAppTraits{}, n.apps...),
+	})
+}
+
+func (n *Node) peersList(w http.ResponseWriter, r *http.Request, body []byte) {
+	writeJSON(w, append([]models.PeerModel{}, n.peers...))
+}
+
+func (n *Node) mirrorsList(w http.ResponseWriter, r *http.Request, body []byte) {
+	writeJSON(w, n.mirrorModels(n.mirrors))
+}
+
+func (n *Node) mirrorAdd(w http.ResponseWriter, r *http.Request, body []byte) {
+	var chains []string
+	if !decodeBody(w, body, &chains) {
+		return
+	}
+	var added []string
+	for _, c := range chains {
+		if !containsString(n.mirrors, c) {
+			n.mirrors = append(n.mirrors, c)
+			added = append(added, c)
+		}
+	}
+	writeJSON(w, n.mirrorModels(added))
+}
+
+func (n *Node) mirrorModels(ids []string) []models.ChainIdModel {
+	ret := make([]models.ChainIdModel, 0, len(ids))
+	for _, id := range ids {
+		ret = append(ret, models.ChainIdModel{Id: id})
+	}
+	return ret
+}
+
+func (n *Node) interlockingsList(w http.ResponseWriter, r *http.Request, target string) {
+	q := newQueryParser(r)
+	lastKnownBlock := q.int64("lastKnownBlock", -1)
+	lastToFirst := q.bool("lastToFirst", false)
+	page := q.int64("page", 0)
+	pageSize := q.int64("pageSize", DefaultPageSize)
+	if q.failed(w) {
+		return
+	}
+	var items []models.InterlockingRecordModel
+	for _, id := range n.chainOrder {
+		for _, i := range n.chains[id].interlockings {
+			if i.InterlockedChainId == target && i.InterlockedRecordSerial > lastKnownBlock {
+				items = append(items, i)
+			}
+		}
+	}
+	items, total := paginate(items, page, pageSize, lastToFirst)
+	writeJSON(w, models.InterlockingRecordModelPageOf{
+		Items:              items,
+		Page:               int32(page),
+		PageSize:           int32(pageSize),
+		TotalNumberOfPages: int32(total),
+		LastToFirst:        lastToFirst,
+	})
+}
+
+//------------------------------------------------------------------------------
+// Chains
+
+func (n *Node) chainsList(w http.ResponseWriter, r *http.Request, body []byte) {
+	ret := make([]models.ChainIdModel, 0, len(n.chainOrder))
+	for _, id := range n.chainOrder {
+		ret = append(ret, chainIdModel(n.chains[id]))
+	}
+	writeJSON(w, ret)
+}
+
+func (n *Node) chainCreate(w http.ResponseWriter, r *http.Request, body []byte) {
+	var params models.ChainCreationModel
+	if !decodeBody(w, body, &params) {
+		return
+	}
+	if params.Name == "" {
+		writeProblem(w, http.StatusUnprocessableEntity, "the name of the chain is required")
+		return
+	}
+	c := n.createChain(params.Name, params.Description, params.AdditionalApps)
+	m := chainIdModel(c)
+	writeJSON(w, models.ChainCreatedModel{
+		Id:          m.Id,
+		LastRecord:  m.LastRecord,
+		LastUpdate:  m.LastUpdate,
+		Name:        m.Name,
+		SizeInBytes: m.SizeInBytes,
+	})
+}
+
+func (n *Node) chainDetails(w http.ResponseWriter, r *http.Request, body []byte, c *chain) {
+	writeJSON(w, c.summary)
+}
+
+func (n *Node) activeAppsList(w http.ResponseWriter, r *http.Request, body []byte, c *chain) {
+	writeJSON(w, append([]int64{}, c.summary.ActiveApps...))
+}
+
+func (n *Node) activeAppsAdd(w http.ResponseWriter, r *http.Request, body []byte, c *chain) {
+	var apps []int64
+	if !decodeBody(w, body, &apps) {
+		return
+	}
+	for _, app := range apps {
+		found := false
+		for _, a := range c.summary.ActiveApps {
+			found = found || a == app
+		}
+		if !found {
+			c.summary.ActiveApps = append(c.summary.ActiveApps, app)
+		}
+	}
+	writeJSON(w, append([]int64{}, c.summary.ActiveApps...))
+}
+
+func (n *Node) permittedKeysList(w http.ResponseWriter, r *http.Request, body []byte, c *chain) {
+	writeJSON(w, append([]models.KeyDetailsModel{}, c.keys...))
+}
+
+func (n *Node) permittedKeysAdd(w http.ResponseWriter, r *http.Request, body []byte, c *chain) {
+	var keys []models.KeyPermitModel
+	if !decodeBody(w, body, &keys) {
+		return
+	}
+	for _, k := range keys {
+		if k.Id == "" || k.PublicKey == "" {
+			writeProblem(w, http.StatusUnprocessableEntity, "the key id and public key are required")
+			return
+		}
+	}
+	for _, k := range keys {
+		c.keys = append(c.keys, models.KeyDetailsModel{
+			Name:        k.Name,
+			Permissions: k.Permissions,
+			Purposes:    k.Purposes,
+			Id:          k.Id,
+			PublicKey:   k.PublicKey,
+		})
+	}
+	writeJSON(w, append([]models.KeyDetailsModel{}, c.keys...))
+}
+
+func (n *Node) chainInterlockingsList(w http.ResponseWriter, r *http.Request, body []byte, c *chain) {
+	q := newQueryParser(r)
+	howManyFromLast := q.int64("howManyFromLast", 0)
+	page := q.int64("page", 0)
+	pageSize := q.int64("pageSize", DefaultPageSize)
+	if q.failed(w) {
+		return
+	}
+	items := c.interlockings
+	if howManyFromLast > 0 && int64(len(items)) > howManyFromLast {
+		items = items[int64(len(items))-howManyFromLast:]
+	}
+	items, total := paginate(items, page, pageSize, false)
+	writeJSON(w, models.InterlockingRecordModelPageOf{
+		Items:              items,
+		Page:               int32(page),
+		PageSize:           int32(pageSize),
+		TotalNumberOfPages: int32(total),
+	})
+}
+
+func (n *Node) chainInterlockingAdd(w http.ResponseWriter, r *http.Request, body []byte, c *chain) {
+	var params models.ForceInterlockModel
+	if !decodeBody(w, body, &params) {
+		return
+	}
+	target, ok := n.chains[params.TargetChain]
+	if !ok {
+		writeProblem(w, http.StatusUnprocessableEntity, fmt.Sprintf("target chain %q not found", params.TargetChain))
+		return
+	}
+	if target.summary.LastRecord < params.MinSerial {
+		writeProblem(w, http.StatusUnprocessableEntity, fmt.Sprintf(
+			"the last record of the target chain is %d", target.summary.LastRecord))
+		return
+	}
+	if !n.checkOpen(w, c) {
+		return
+	}
+	last := target.records[len(target.records)-1]
+	rec := n.appendRecord(c, 0, 0, nil, models.DATA_RecordType).model
+	interlocking := models.InterlockingRecordModel{
+		ApplicationId:           rec.ApplicationId,
+		ChainId:                 rec.ChainId,
+		CreatedAt:               rec.CreatedAt,
+		Hash:                    rec.Hash,
+		Network:                 &models.NetworkId{Name: n.network},
+		PayloadTagId:            rec.PayloadTagId,
+		Reference:               rec.Reference,
+		Serial:                  rec.Serial,
+		Type_:                   rec.Type_,
+		Version:                 rec.Version,
+		PayloadBytes:            rec.PayloadBytes,
+		InterlockedChainId:      target.summary.Id,
+		InterlockedRecordHash:   last.model.Hash,
+		InterlockedRecordOffset: last.model.Serial,
+		InterlockedRecordSerial: last.model.Serial,
+	}
+	c.interlockings = append(c.interlockings, interlocking)
+	writeJSON(w, interlocking)
+}
+
+//------------------------------------------------------------------------------
+// Records
+
+func (n *Node) recordsList(asJson bool) func(w http.ResponseWriter, r *http.Request, body []byte, c *chain) {
+	return func(w http.ResponseWriter, r *http.Request, body []byte, c *chain) {
+		q := newQueryParser(r)
+		firstSerial := q.int64("firstSerial", 0)
+		lastSerial := q.int64("lastSerial", c.summary.LastRecord)
+		page := q.int64("page", 0)
+		pageSize := q.int64("pageSize", DefaultPageSize)
+		lastToFirst := q.bool("lastToFirst", false)
+		if q.failed(w) {
+			return
+		}
+		var items []*record
+		for _, rec := range c.records {
+			if rec.model.Serial >= firstSerial && rec.model.Serial <= lastSerial {
+				items = append(items, rec)
+			}
+		}
+		items, total := paginate(items, page, pageSize, lastToFirst)
+		n.writeRecordPage(w, asJson, items, page, pageSize, total, lastToFirst)
+	}
+}
+
+func (n *Node) recordsQuery(asJson bool) func(w http.ResponseWriter, r *http.Request, body []byte, c *chain) {
+	return func(w http.ResponseWriter, r *http.Request, body []byte, c *chain) {
+		q := newQueryParser(r)
+		howMany := q.int64("howMany", 0)
+		page := q.int64("page", 0)
+		pageSize := q.int64("pageSize", DefaultPageSize)
+		lastToFirst := q.bool("lastToFirst", false)
+		if q.failed(w) {
+			return
+		}
+		// InterlockQL is not supported, thus all records match.
+		items := append([]*record{}, c.records...)
+		if lastToFirst {
+			reverse(items)
+		}
+		if howMany > 0 && int64(len(items)) > howMany {
+			items = items[:howMany]
+		}
+		items, total := paginate(items, page, pageSize, false)
+		n.writeRecordPage(w, asJson, items, page, pageSize, total, lastToFirst)
+	}
+}
+
+// Writes a page of records in the binary or the JSON form.
+func (n *Node) writeRecordPage(w http.ResponseWriter, asJson bool, items []*record,
+	page, pageSize, total int64, lastToFirst bool) {
+	if asJson {
+		ret := models.RecordModelAsJsonPageOf{
+			Items:              make([]models.RecordModelAsJson, 0, len(items)),
+			Page:               int32(page),
+			PageSize:           int32(pageSize),
+			TotalNumberOfPages: int32(total),
+			LastToFirst:        lastToFirst,
+		}
+		for _, rec := range items {
+			ret.Items = append(ret.Items, n.recordAsJson(rec))
+		}
+		writeJSON(w, ret)
+	} else {
+		ret := models.RecordModelPageOf{
+			Items:              make([]models.RecordModel, 0, len(items)),
+			Page:               int32(page),
+			PageSize:           int32(pageSize),
+			TotalNumberOfPages: int32(total),
+			LastToFirst:        lastToFirst,
+		}
+		for _, rec := range items {
+			ret.Items = append(ret.Items, rec.model)
+		}
+		writeJSON(w, ret)
+	}
+}
+
+/*
+Converts the record into its JSON form. The payload of the records that were
+not added as JSON is represented by the base64 of its bytes.
+*/
+func (n *Node) recordAsJson(rec *record) models.RecordModelAsJson {
+	var payload models.Object = rec.json
+	if payload == nil {
+		payload = rec.model.PayloadBytes
+	}
+	m := rec.model
+	return models.RecordModelAsJson{
+		ApplicationId: m.ApplicationId,
+		ChainId:       m.ChainId,
+		CreatedAt:     m.CreatedAt,
+		Hash:          m.Hash,
+		Network:       &models.NetworkId{Name: m.Network},
+		PayloadTagId:  m.PayloadTagId,
+		Reference:     m.Reference,
+		Serial:        m.Serial,
+		Type_:         m.Type_,
+		Version:       m.Version,
+		Payload:       &payload,
+	}
+}
+
+func (n *Node) recordGet(asJson bool) func(w http.ResponseWriter, r *http.Request, c *chain, rec *record) {
+	return func(w http.ResponseWriter, r *http.Request, c *chain, rec *record) {
+		if asJson {
+			writeJSON(w, n.recordAsJson(rec))
+		} else {
+			writeJSON(w, rec.model)
+		}
+	}
+}
+
+func (n *Node) recordAdd(w http.ResponseWriter, r *http.Request, body []byte, c *chain) {
+	var params models.NewRecordModel
+	if !decodeBody(w, body, &params) {
+		return
+	}
+	payload, err := models.DecodeBytes(params.PayloadBytes)
+	if err != nil || len(payload) == 0 {
+		writeProblem(w, http.StatusUnprocessableEntity, "invalid payloadBytes")
+		return
+	}
+	recordType, ok := newRecordType(w, params.Type_)
+	if !ok || !n.checkOpen(w, c) {
+		return
+	}
+	rec := n.appendRecord(c, params.ApplicationId, payloadTagId(payload), payload, recordType)
+	n.closeIfRequired(c, recordType)
+	writeJSON(w, rec.model)
+}
+
+func (n *Node) recordAddAsJson(w http.ResponseWriter, r *http.Request, body []byte, c *chain) {
+	q := newQueryParser(r)
+	applicationId := q.int64("applicationId", 0)
+	payloadTagId := q.int64("payloadTagId", 0)
+	if q.failed(w) {
+		return
+	}
+	var payload any
+	if !decodeBody(w, body, &payload) {
+		return
+	}
+	var recordType *models.RecordType
+	if t := r.URL.Query().Get("type"); t != "" {
+		v := models.RecordType(t)
+		recordType = &v
+	}
+	rt, ok := newRecordType(w, recordType)
+	if !ok || !n.checkOpen(w, c) {
+		return
+	}
+	rec := n.appendJSONRecord(c, applicationId, payloadTagId, payload, rt)
+	n.closeIfRequired(c, rt)
+	writeJSON(w, rec.model)
+}
+
+// Validates the type of a new record. Data is used if it is not set.
+func newRecordType(w http.ResponseWriter, recordType *models.RecordType) (models.RecordType, bool) {
+	if recordType == nil {
+		return models.DATA_RecordType, true
+	}
+	switch *recordType {
+	case models.DATA_RecordType, models.CLOSING_RecordType, models.EMERGENCY_CLOSING_RecordType:
+		return *recordType, true
+	}
+	writeProblem(w, http.StatusUnprocessableEntity, fmt.Sprintf("invalid record type %q", *recordType))
+	return "", false
+}
+
+// Ensures that the chain accepts new records.
+func (n *Node) checkOpen(w http.ResponseWriter, c *chain) bool {
+	if c.summary.IsClosedForNewTransactions {
+		writeProblem(w, http.StatusUnprocessableEntity, fmt.Sprintf("chain %s is closed", c.summary.Id))
+		return false
+	}
+	return true
+}
+
+// Closes the chain if the record type requires it.
+func (n *Node) closeIfRequired(c *chain, recordType models.RecordType) {
+	if recordType == models.CLOSING_RecordType || recordType == models.EMERGENCY_CLOSING_RecordType {
+		c.summary.IsClosedForNewTransactions = true
+	}
+}
+
+//------------------------------------------------------------------------------
+// JSON documents
+
+func (n *Node) jsonDocumentsAdd(w http.ResponseWriter, r *http.Request, body []byte, c *chain) {
+	var payload any
+	if !decodeBody(w, body, &payload) {
+		return
+	}
+	if !n.checkOpen(w, c) {
+		return
+	}
+	var compact bytes.Buffer
+	json.Compact(&compact, body)
+	rec := n.appendJSONRecord(c, JSONDocumentsAppId, 0, payload, models.DATA_RecordType).model
+	doc := models.JsonDocumentModel{
+		ApplicationId: rec.ApplicationId,
+		ChainId:       rec.ChainId,
+		CreatedAt:     rec.CreatedAt,
+		Hash:          rec.Hash,
+		Network:       rec.Network,
+		PayloadTagId:  rec.PayloadTagId,
+		Reference:     rec.Reference,
+		Serial:        rec.Serial,
+		Type_:         rec.Type_,
+		Version:       rec.Version,
+		JsonText:      compact.String(),
+	}
+	c.jsonDocuments[rec.Serial] = doc
+	writeJSON(w, doc)
+}
+
+func (n *Node) jsonDocumentsAllowReaders(w http.ResponseWriter, r *http.Request, body []byte, c *chain) {
+	var params models.AllowedReadersModel
+	if !decodeBody(w, body, &params) {
+		return
+	}
+	if params.ContextId == "" || len(params.Readers) == 0 {
+		writeProblem(w, http.StatusUnprocessableEntity, "the context id and the readers are required")
+		return
+	}
+	if !n.checkOpen(w, c) {
+		return
+	}
+	rec := n.appendJSONRecord(c, JSONDocumentsAppId, 0, params, models.DATA_RecordType)
+	writeJSON(w, rec.model.Reference)
+}
+
+func (n *Node) jsonDocumentsGet(w http.ResponseWriter, r *http.Request, c *chain, rec *record) {
+	doc, ok := c.jsonDocuments[rec.model.Serial]
+	if !ok {
+		writeProblem(w, http.StatusNotFound, fmt.Sprintf("record %s is not a JSON document", rec.model.Reference))
+		return
+	}
+	writeJSON(w, doc)
+}
+
+//------------------------------------------------------------------------------
+// Documents
+
+func (n *Node) documentsGetConfig(w http.ResponseWriter, r *http.Request, body []byte) {
+	writeJSON(w, models.DocumentUploadConfiguration{
+		DefaultCompression:    "None",
+		DefaultEncryption:     "None",
+		FileSizeLimit:         documentSizeLimit,
+		Iterations:            1000,
+		PermittedContentTypes: permittedContentTypes,
+		TimeOutInMinutes:      10,
+	})
+}
+
+func (n *Node) documentsBeginTransaction(w http.ResponseWriter, r *http.Request, body []byte) {
+	var params models.DocumentsBeginTransactionModel
+	if !decodeBody(w, body, &params) {
+		return
+	}
+	if _, ok := n.chains[params.Chain]; !ok {
+		writeProblem(w, http.StatusUnprocessableEntity, fmt.Sprintf("chain %q not found", params.Chain))
+		return
+	}
+	t := &documentsTransaction{
+		begin: params,
+		model: models.DocumentsTransactionModel{
+			Chain:                   params.Chain,
+			Comment:                 params.Comment,
+			Compression:             params.Compression,
+			Encryption:              params.Encryption,
+			GeneratePublicDirectory: params.GeneratePublicDirectory,
+			Previous:                params.Previous,
+			TimeOutLimit:            n.now().UTC().Add(10 * time.Minute),
+			TransactionId:           randomId(),
+		},
+	}
+	n.transactions[t.model.TransactionId] = t
+	writeJSON(w, t.model)
+}
+
+func (n *Node) documentsAddDocument(w http.ResponseWriter, r *http.Request, body []byte, t *documentsTransaction) {
+	query := r.URL.Query()
+	name := query.Get("name")
+	if name == "" {
+		writeProblem(w, http.StatusUnprocessableEntity, "the name of the document is required")
+		return
+	}
+	contentType := r.Header.Get("Content-Type")
+	if !containsString(permittedContentTypes, contentType) {
+		writeProblem(w, http.StatusUnprocessableEntity, fmt.Sprintf("content type %q is not permitted", contentType))
+		return
+	}
+	if len(body) > documentSizeLimit {
+		writeProblem(w, http.StatusUnprocessableEntity, "the document is too large")
+		return
+	}
+	t.documents = append(t.documents, document{
+		entry: models.DirectoryEntry{
+			Comment:  query.Get("comment"),
+			MimeType: contentType,
+			Name:     name,
+			Path:     query.Get("path"),
+		},
+		data: body,
+	})
+	t.model.CountOfUploadedDocuments++
+	t.model.DocumentNames = append(t.model.DocumentNames, name)
+	t.model.CanCommitNow = true
+	writeJSON(w, t.model)
+}
+
+func (n *Node) documentsGetTransactionStatus(w http.ResponseWriter, r *http.Request, body []byte, t *documentsTransaction) {
+	writeJSON(w, t.model)
+}
+
+func (n *Node) documentsCommitTransaction(w http.ResponseWriter, r *http.Request, body []byte, t *documentsTransaction) {
+	if len(t.documents) == 0 {
+		writeProblem(w, http.StatusUnprocessableEntity, "the transaction has no documents")
+		return
+	}
+	c := n.chains[t.model.Chain]
+	if !n.checkOpen(w, c) {
+		return
+	}
+	rec := n.appendJSONRecord(c, DocumentsAppId, 0, t.model.DocumentNames, models.DATA_RecordType)
+	delete(n.transactions, t.model.TransactionId)
+	n.documents[rec.model.Reference] = t
+	writeJSON(w, rec.model.Reference)
+}
+
+func (n *Node) documentsGetMetadata(w http.ResponseWriter, r *http.Request, t *documentsTransaction) {
+	m := models.DocumentsMetadataModel{
+		Comment:     t.model.Comment,
+		Compression: t.model.Compression,
+		Encryption:  t.model.Encryption,
+	}
+	for _, d := range t.documents {
+		m.PublicDirectory = append(m.PublicDirectory, d.entry)
+	}
+	writeJSON(w, m)
+}
+
+func (n *Node) documentsGetAll(w http.ResponseWriter, r *http.Request, t *documentsTransaction) {
+	var buff bytes.Buffer
+	z := zip.NewWriter(&buff)
+	for _, d := range t.documents {
+		f, err := z.Create(d.entry.Name)
+		if err == nil {
+			_, err = f.Write(d.data)
+		}
+		if err != nil {
+			writeProblem(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+	if err := z.Close(); err != nil {
+		writeProblem(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/zip")
+	w.Write(buff.Bytes())
+}
+
+func (n *Node) documentsGetSingle(w http.ResponseWriter, t *documentsTransaction, index string) {
+	i, err := strconv.Atoi(index)
+	if err != nil || i < 0 || i >= len(t.documents) {
+		writeProblem(w, http.StatusNotFound, fmt.Sprintf("document %s not found", index))
+		return
+	}
+	d := t.documents[i]
+	w.Header().Set("Content-Type", d.entry.MimeType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", d.entry.Name))
+	w.Write(d.data)
+}
+
+//------------------------------------------------------------------------------
+// Opaque
+
+func (n *Node) opaqueCreate(w http.ResponseWriter, r *http.Request, body []byte, c *chain) {
+	q := newQueryParser(r)
+	appId := q.int64("appId", -1)
+	payloadTypeId := q.int64("payloadTypeId", -1)
+	lastChanged := q.int64("lastChangedRecordSerial", -1)
+	if q.failed(w) {
+		return
+	}
+	if appId < 0 || payloadTypeId < 0 {
+		writeProblem(w, http.StatusBadRequest, "appId and payloadTypeId are required")
+		return
+	}
+	if lastChanged >= 0 {
+		last := int64(-1)
+		for _, rec := range c.records {
+			if rec.opaque && rec.model.ApplicationId == appId && rec.model.PayloadTagId == payloadTypeId {
+				last = rec.model.Serial
+			}
+		}
+		if last != lastChanged {
+			writeProblem(w, http.StatusConflict, fmt.Sprintf("the last changed record is %d", last))
+			return
+		}
+	}
+	if !n.checkOpen(w, c) {
+		return
+	}
+	rec := n.appendRecord(c, appId, payloadTypeId, body, models.DATA_RecordType)
+	rec.opaque = true
+	writeJSON(w, opaqueRecordModel(rec))
+}
+
+func (n *Node) opaqueGet(w http.ResponseWriter, r *http.Request, c *chain, rec *record) {
+	if !rec.opaque {
+		writeProblem(w, http.StatusNotFound, fmt.Sprintf("record %s is not opaque", rec.model.Reference))
+		return
+	}
+	payload, _ := models.DecodeBytes(rec.model.PayloadBytes)
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("x-app-id", strconv.FormatInt(rec.model.ApplicationId, 10))
+	w.Header().Set("x-payload-type-id", strconv.FormatInt(rec.model.PayloadTagId, 10))
+	w.Write(payload)
+}
+
+func (n *Node) opaqueQueryJson(w http.ResponseWriter, r *http.Request, body []byte, c *chain) {
+	q := newQueryParser(r)
+	appId := q.int64("appId", -1)
+	howMany := q.int64("howMany", 0)
+	lastToFirst := q.bool("lastToFirst", false)
+	page := q.int64("page", 0)
+	pageSize := q.int64("pageSize", DefaultPageSize)
+	var payloadTypeIds []int64
+	for _, v := range r.URL.Query()["payloadTypeIds"] {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			writeProblem(w, http.StatusBadRequest, fmt.Sprintf("invalid payloadTypeIds %q", v))
+			return
+		}
+		payloadTypeIds = append(payloadTypeIds, id)
+	}
+	if q.failed(w) {
+		return
+	}
+	var items []models.OpaqueRecordModel
+	lastChanged := int64(0)
+	for _, rec := range c.records {
+		if !rec.opaque || rec.model.ApplicationId != appId {
+			continue
+		}
+		if len(payloadTypeIds) > 0 && !containsInt64(payloadTypeIds, rec.model.PayloadTagId) {
+			continue
+		}
+		items = append(items, opaqueRecordModel(rec))
+		lastChanged = rec.model.Serial
+	}
+	if lastToFirst {
+		reverse(items)
+	}
+	if howMany > 0 && int64(len(items)) > howMany {
+		items = items[:howMany]
+	}
+	items, total := paginate(items, page, pageSize, false)
+	writeJSON(w, models.PageOfOpaqueRecordsModel{
+		Items:                   items,
+		Page:                    int(page),
+		PageSize:                int(pageSize),
+		TotalNumberOfPages:      int(total),
+		LastToFirst:             lastToFirst,
+		LastChangedRecordSerial: lastChanged,
+	})
+}
+
+func opaqueRecordModel(rec *record) models.OpaqueRecordModel {
+	return models.OpaqueRecordModel{
+		Network:       rec.model.Network,
+		ChainId:       rec.model.ChainId,
+		Serial:        rec.model.Serial,
+		ApplicationId: rec.model.ApplicationId,
+		PayloadTagId:  rec.model.PayloadTagId,
+		CreatedAt:     rec.model.CreatedAt,
+	}
+}
+
+//------------------------------------------------------------------------------
+// Utilities
+
+func chainIdModel(c *chain) models.ChainIdModel {
+	return models.ChainIdModel{
+		Id:                         c.summary.Id,
+		IsClosedForNewTransactions: c.summary.IsClosedForNewTransactions,
+		LastRecord:                 c.summary.LastRecord,
+		LastUpdate:                 c.summary.LastUpdate,
+		LicensingStatus:            c.summary.LicensingStatus,
+		Name:                       c.summary.Name,
+		SizeInBytes:                c.summary.SizeInBytes,
+	}
+}
+
+/*
+Returns the requested page of items and the total number of pages. The pages
+start at zero.
+*/
+func paginate[T any](items []T, page, pageSize int64, lastToFirst bool) ([]T, int64) {
+	items = append([]T{}, items...)
+	if lastToFirst {
+		reverse(items)
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	total := (int64(len(items)) + pageSize - 1) / pageSize
+	start := page * pageSize
+	if page < 0 || start >= int64(len(items)) {
+		return []T{}, total
+	}
+	end := start + pageSize
+	if end > int64(len(items)) {
+		end = int64(len(items))
+	}
+	return items[start:end], total
+}
+
+func reverse[T any](items []T) {
+	for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+		items[i], items[j] = items[j], items[i]
+	}
+}
+
+func containsString(values []string, v string) bool {
+	for _, s := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt64(values []int64, v int64) bool {
+	for _, s := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Parses the query parameters, keeping the first error found.
+type queryParser struct {
+	values url.Values
+	err    error
+}
+
+func newQueryParser(r *http.Request) *queryParser {
+	return &queryParser{values: r.URL.Query()}
+}
+
+func (q *queryParser) int64(name string, defaultValue int64) int64 {
+	s := q.values.Get(name)
+	if s == "" || q.err != nil {
+		return defaultValue
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		q.err = fmt.Errorf("invalid %s %q", name, s)
+		return defaultValue
+	}
+	return v
+}
+
+func (q *queryParser) bool(name string, defaultValue bool) bool {
+	s := q.values.Get(name)
+	if s == "" || q.err != nil {
+		return defaultValue
+	}
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		q.err = fmt.Errorf("invalid %s %q", name, s)
+		return defaultValue
+	}
+	return v
+}
+
+// Writes a 400 response if one of the parameters is invalid.
+func (q *queryParser) failed(w http.ResponseWriter) bool {
+	if q.err != nil {
+		writeProblem(w, http.StatusBadRequest, q.err.Error())
+		return true
+	}
+	return false
+}
+
+// Decodes the JSON body. It writes a 400 response on failure.
+func decodeBody(w http.ResponseWriter, body []byte, v any) bool {
+	if err := json.Unmarshal(body, v); err != nil {
+		writeProblem(w, http.StatusBadRequest, fmt.Sprintf("invalid body: %v", err))
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(v)
+}
+
+// Writes an RFC 7807 problem.
+func writeProblem(w http.ResponseWriter, status int, detail string) {
+	w.Header().Set("Content-Type", "application/problem+json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]any{
+		"title":  http.StatusText(status),
+		"status": status,
+		"detail": detail,
+	})
+}
@@ -31,10 +31,18 @@
 package client
 
 import (
+	stdcrypto "crypto"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/interlockledger/go-interlockledger-rest-client/crypto"
 )
@@ -53,21 +61,94 @@ type Configuration struct {
 	UserAgent string `json:"userAgent,omitempty"`
 	// If true, the server connections will not be validated.
 	NoServerVerification bool `json:"noServerVerification"`
+	// Path to a PEM file with additional trusted root certificates.
+	CAFile string `json:"caFile,omitempty"`
+	// Path to a directory with PEM files (*.pem, *.crt or *.cer) with
+	// additional trusted root certificates.
+	CADir string `json:"caDir,omitempty"`
+	// If true, the system root certificates will not be trusted. Only the
+	// certificates from CAFile and CADir will be used.
+	NoSystemCAs bool `json:"noSystemCAs,omitempty"`
+	// SPKI SHA-256 fingerprints of the pinned server certificates (see
+	// crypto.ParseFingerprint()). If set, the server certificate must match one
+	// of the pins and NoServerVerification is ignored.
+	ServerPins []string `json:"serverPins,omitempty"`
+	// Path to a PEM file with the pinned server certificates. It can be used
+	// with or instead of ServerPins.
+	PinnedCertFile string `json:"pinnedCertFile,omitempty"`
 	// Path to the client certificate file (PEM).
 	CertFile string `json:"certFile,omitempty"`
 	// Path to the client key file (PEM).
 	KeyFile string `json:"keyFile,omitempty"`
+	// Password of the client key file. It is required only if the key is
+	// encrypted.
+	KeyPassword string `json:"keyPassword,omitempty"`
 	// Path to the client PFX certificate/key file.
 	PFXFile string `json:"pfxFile,omitempty"`
 	// Password of the client PFX certificate/key file.
 	PFXPassword string `json:"pfxPassword,omitempty"`
+	// The retry policy used on transient errors. If nil, no retries will be
+	// performed.
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+	// Additional endpoints of nodes that mirror the chains of BasePath. If set,
+	// idempotent requests are distributed among BasePath and these endpoints
+	// and fail over to the next one on connection errors or 5xx responses.
+	// All other requests are always sent to BasePath.
+	Endpoints []string `json:"endpoints,omitempty"`
+	// Time a failed endpoint remains out of the rotation. If zero,
+	// DefaultFailoverCooldown will be used. In JSON and YAML, it can be written
+	// either as a duration string such as "30s" or as a number of nanoseconds.
+	FailoverCooldown time.Duration `json:"failoverCooldown,omitempty"`
+	// Maximum number of immutable records kept in memory by the response
+	// cache. If zero, the responses will not be cached in memory.
+	CacheSize int `json:"cacheSize,omitempty"`
+	// Directory used to store the immutable records cached by the client. If
+	// set, it is used instead of the in-memory cache. The records are stored in
+	// plaintext, including the decrypted JSON payloads (see DirCache). Init()
+	// fails if the directory cannot be created.
+	CacheDir string `json:"cacheDir,omitempty"`
+	// Time limit of each request, including the time to read the response
+	// body. If zero, there is no timeout. This and the other timeouts have the
+	// same format of FailoverCooldown.
+	Timeout time.Duration `json:"timeout,omitempty"`
+	// Maximum amount of time a dial will wait for a connection to complete. If
+	// zero, there is no timeout other than the one imposed by the operating
+	// system.
+	DialTimeout time.Duration `json:"dialTimeout,omitempty"`
+	// Maximum amount of time to wait for the TLS handshake. If zero, there is
+	// no timeout.
+	TLSHandshakeTimeout time.Duration `json:"tlsHandshakeTimeout,omitempty"`
+	// Maximum amount of time to wait for the response headers after the
+	// request is written. If zero, there is no timeout.
+	ResponseHeaderTimeout time.Duration `json:"responseHeaderTimeout,omitempty"`
+	// Maximum number of idle connections kept per host. If zero,
+	// http.DefaultMaxIdleConnsPerHost is used.
+	MaxIdleConnsPerHost int `json:"maxIdleConnsPerHost,omitempty"`
+	// If true, HTTP/2 will be negotiated with the server when possible.
+	EnableHTTP2 bool `json:"enableHTTP2,omitempty"`
+	// URL of the proxy server. If empty, the proxy is selected from the
+	// environment variables HTTPS_PROXY, HTTP_PROXY and NO_PROXY.
+	ProxyURL string `json:"proxyUrl,omitempty"`
+	// If true, no proxy will be used, regardless of ProxyURL and the
+	// environment variables.
+	DisableProxy bool `json:"disableProxy,omitempty"`
 	// The client associated with this configuration.
 	HTTPClient *http.Client `json:"-"`
 	// The set of client certificates to be used. It will be initialized according
 	// to the current configuration if necessary.
 	ClientCertificates []tls.Certificate `json:"-"`
+	// The source of the client certificate. If set, it is used instead of
+	// ClientCertificates. It will be initialized by Init() if
+	// CertReloadInterval is set.
+	CertificateSource ClientCertificateSource `json:"-"`
+	// If set, Init() will load the client certificate from PFXFile or
+	// CertFile/KeyFile into a ReloadableCertificate that checks for changes
+	// in the files at most once per interval. It has the same format of
+	// FailoverCooldown.
+	CertReloadInterval time.Duration `json:"certReloadInterval,omitempty"`
 	// The certificate pool to be used. It will be automatically initialized
-	// when required.
+	// with the system certificates (unless NoSystemCAs is set) and the
+	// certificates from CAFile and CADir when required.
 	CertPool *x509.CertPool `json:"-"`
 }
 
@@ -77,10 +158,52 @@ func NewConfiguration() *Configuration {
 		BasePath:      "/",
 		DefaultHeader: make(map[string]string),
 		UserAgent:     "Swagger-Codegen/1.0.0/go",
+		RetryPolicy:   NewRetryPolicy(),
+		// Same timeouts used by http.DefaultTransport.
+		DialTimeout:         30 * time.Second,
+		TLSHandshakeTimeout: 10 * time.Second,
 	}
 	return cfg
 }
 
+// Implements json.Unmarshaler.
+func (c *Configuration) UnmarshalJSON(b []byte) error {
+	// The alias removes this method to avoid an infinite recursion.
+	type plain Configuration
+	tmp := struct {
+		*plain
+		FailoverCooldown      json.RawMessage `json:"failoverCooldown,omitempty"`
+		Timeout               json.RawMessage `json:"timeout,omitempty"`
+		DialTimeout           json.RawMessage `json:"dialTimeout,omitempty"`
+		TLSHandshakeTimeout   json.RawMessage `json:"tlsHandshakeTimeout,omitempty"`
+		ResponseHeaderTimeout json.RawMessage `json:"responseHeaderTimeout,omitempty"`
+		CertReloadInterval    json.RawMessage `json:"certReloadInterval,omitempty"`
+	}{plain: (*plain)(c)}
+	if err := json.Unmarshal(b, &tmp); err != nil {
+		return err
+	}
+	durations := []struct {
+		name  string
+		value json.RawMessage
+		field *time.Duration
+	}{
+		{"failoverCooldown", tmp.FailoverCooldown, &c.FailoverCooldown},
+		{"timeout", tmp.Timeout, &c.Timeout},
+		{"dialTimeout", tmp.DialTimeout, &c.DialTimeout},
+		{"tlsHandshakeTimeout", tmp.TLSHandshakeTimeout, &c.TLSHandshakeTimeout},
+		{"responseHeaderTimeout", tmp.ResponseHeaderTimeout, &c.ResponseHeaderTimeout},
+		{"certReloadInterval", tmp.CertReloadInterval, &c.CertReloadInterval},
+	}
+	for _, d := range durations {
+		v, err := parseJSONDuration(d.value, *d.field)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", d.name, err)
+		}
+		*d.field = v
+	}
+	return nil
+}
+
 // Adds custom headers to all connections from this client.
 func (c *Configuration) AddDefaultHeader(key string, value string) {
 	c.DefaultHeader[key] = value
@@ -107,6 +230,20 @@ func (c *Configuration) SetClientCertificateEx(
 	return c.SetClientCert(cert, noServerVerification)
 }
 
+/*
+Loads the client certificate required to access the API and sets the
+`http.Client`. The key file may be encrypted with the given password. See
+crypto.ParsePrivateKeyWithPassword() for the supported formats.
+*/
+func (c *Configuration) SetEncryptedClientCertificateEx(
+	certificateFile string, keyFile string, password string, noServerVerification bool) error {
+	cert, err := crypto.LoadCertificateWithEncryptedKey(certificateFile, keyFile, password)
+	if err != nil {
+		return err
+	}
+	return c.SetClientCert(cert, noServerVerification)
+}
+
 /*
 Loads the client certificate required to access the API and sets the
 `http.Client`.
@@ -134,16 +271,85 @@ Loads the client certificate required to access the API and sets the
 */
 func (c *Configuration) SetClientCert(cert tls.Certificate, noServerVerification bool) error {
 	c.ClientCertificates = []tls.Certificate{cert}
+	c.CertificateSource = nil
 	return c.createHTTPClient(noServerVerification)
 }
 
+/*
+Sets the client certificate whose private key operations are delegated to the
+given signer and sets the `http.Client`. It allows the use of private keys that
+never touch the file system, such as the ones stored inside key agents or
+hardware keystores. The server verification is set according to
+NoServerVerification.
+*/
+func (c *Configuration) SetClientSigner(chain []*x509.Certificate, signer stdcrypto.Signer) error {
+	cert, err := crypto.NewCertificateWithSigner(chain, signer)
+	if err != nil {
+		return err
+	}
+	return c.SetClientCert(cert, c.NoServerVerification)
+}
+
+/*
+Sets the source of the client certificate and the `http.Client`. It allows the
+rotation of the client certificate without the need to rebuild the client.
+*/
+func (c *Configuration) SetClientCertificateSource(source ClientCertificateSource, noServerVerification bool) error {
+	c.CertificateSource = source
+	return c.createHTTPClient(noServerVerification)
+}
+
+/*
+Creates the certificate pool with the system certificates (unless NoSystemCAs
+is set) and the certificates from CAFile and CADir.
+*/
+func (c *Configuration) createCertPool() (*x509.CertPool, error) {
+	var pool *x509.CertPool
+	if c.NoSystemCAs {
+		pool = x509.NewCertPool()
+	} else {
+		var err error
+		if pool, err = x509.SystemCertPool(); err != nil {
+			return nil, err
+		}
+	}
+	var files []string
+	if c.CAFile != "" {
+		files = append(files, c.CAFile)
+	}
+	if c.CADir != "" {
+		entries, err := os.ReadDir(c.CADir)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			switch strings.ToLower(filepath.Ext(e.Name())) {
+			case ".pem", ".crt", ".cer":
+				if !e.IsDir() {
+					files = append(files, filepath.Join(c.CADir, e.Name()))
+				}
+			}
+		}
+	}
+	for _, file := range files {
+		certs, err := crypto.LoadCertificate(file)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load the CA file %s: %w", file, err)
+		}
+		for _, cert := range certs {
+			pool.AddCert(cert)
+		}
+	}
+	return pool, nil
+}
+
 /*
 Loads the client certificate required to access the API and sets the
 `http.Client`.
 */
 func (c *Configuration) createHTTPClient(noServerVerification bool) error {
 	if c.CertPool == nil {
-		pool, err := x509.SystemCertPool()
+		pool, err := c.createCertPool()
 		if err != nil {
 			return err
 		}
@@ -154,30 +360,112 @@ func (c *Configuration) createHTTPClient(noServerVerification bool) error {
 		RootCAs:            c.CertPool,
 		InsecureSkipVerify: noServerVerification,
 	}
-	c.HTTPClient = &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+	if c.CertificateSource != nil {
+		tlsConfig.Certificates = nil
+		tlsConfig.GetClientCertificate = c.CertificateSource.GetClientCertificate
+	}
+	pins, err := c.serverPins()
+	if err != nil {
+		return err
+	}
+	if len(pins) > 0 {
+		// The pins replace the verification of the certificate chain.
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyConnection = newPinVerifier(pins)
+	}
+	transport, err := c.createTransport(tlsConfig)
+	if err != nil {
+		return err
+	}
+	c.HTTPClient = &http.Client{Transport: transport, Timeout: c.Timeout}
 	return nil
 }
 
+// Creates the transport according to the transport settings.
+func (c *Configuration) createTransport(tlsConfig *tls.Config) (*http.Transport, error) {
+	var proxy func(*http.Request) (*url.URL, error)
+	if !c.DisableProxy {
+		if c.ProxyURL != "" {
+			proxyURL, err := url.Parse(c.ProxyURL)
+			if err != nil {
+				return nil, fmt.Errorf("invalid proxy URL: %w", err)
+			}
+			if proxyURL.Scheme == "" || proxyURL.Host == "" {
+				return nil, fmt.Errorf("invalid proxy URL: %q", c.ProxyURL)
+			}
+			proxy = http.ProxyURL(proxyURL)
+		} else {
+			proxy = http.ProxyFromEnvironment
+		}
+	}
+	dialer := &net.Dialer{
+		Timeout:   c.DialTimeout,
+		KeepAlive: 30 * time.Second,
+	}
+	return &http.Transport{
+		Proxy:                 proxy,
+		DialContext:           dialer.DialContext,
+		TLSClientConfig:       tlsConfig,
+		TLSHandshakeTimeout:   c.TLSHandshakeTimeout,
+		ResponseHeaderTimeout: c.ResponseHeaderTimeout,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   c.MaxIdleConnsPerHost,
+		IdleConnTimeout:       90 * time.Second,
+		ExpectContinueTimeout: time.Second,
+		ForceAttemptHTTP2:     c.EnableHTTP2,
+	}, nil
+}
+
 /*
 Initializes the inner HTTPClient using the current parameters.
 
 It will load the client certificate from either PFXFile (preffered) or
-CertFile/KeyFile parameters and enable or disable the server
-verification based on the value of NoServerVerification.
+CertFile/KeyFile parameters and enable or disable the server verification based
+on the value of NoServerVerification. If KeyPassword is set, it will be used to
+decrypt the key from KeyFile. If CertReloadInterval is set, the certificate will
+be loaded into a ReloadableCertificate set as the CertificateSource. The
+transport is configured according to the timeout, connection pool, HTTP/2 and
+proxy settings.
 
 If called more than once, the previos HTTPClient will be replaced by the new one.
 
 On success, if the fields CertPool and ClientCertificates are not initialized,
 they will be initialized according to the current configuration.
 
-If fails if there is no valid client certificate to load.
+If fails if there is no valid client certificate to load, if BasePath or
+Endpoints are invalid when Endpoints is set or if CacheDir cannot be created.
 */
 func (c *Configuration) Init() error {
 
-	if c.ClientCertificates != nil {
+	if len(c.Endpoints) > 0 {
+		if _, err := newFailoverRouter(c.BasePath, c.Endpoints, c.FailoverCooldown); err != nil {
+			return err
+		}
+	}
+	if c.CacheDir != "" {
+		if err := os.MkdirAll(c.CacheDir, 0700); err != nil {
+			return fmt.Errorf("unable to create the cache directory: %w", err)
+		}
+	}
+	if c.CertificateSource != nil || c.ClientCertificates != nil {
 		return c.createHTTPClient(c.NoServerVerification)
+	} else if c.CertReloadInterval > 0 && (c.PFXFile != "" || c.CertFile != "") {
+		var source *ReloadableCertificate
+		var err error
+		if c.PFXFile != "" {
+			source, err = NewReloadableCertificatePKCS12(c.PFXFile, c.PFXPassword)
+		} else {
+			source, err = NewReloadableCertificateWithPassword(c.CertFile, c.KeyFile, c.KeyPassword)
+		}
+		if err != nil {
+			return err
+		}
+		source.CheckInterval = c.CertReloadInterval
+		return c.SetClientCertificateSource(source, c.NoServerVerification)
 	} else if c.PFXFile != "" {
 		return c.SetClientCertificatePKCS12Ex(c.PFXFile, c.PFXPassword, c.NoServerVerification)
+	} else if c.CertFile != "" && c.KeyPassword != "" {
+		return c.SetEncryptedClientCertificateEx(c.CertFile, c.KeyFile, c.KeyPassword, c.NoServerVerification)
 	} else if c.CertFile != "" {
 		return c.SetClientCertificateEx(c.CertFile, c.KeyFile, c.NoServerVerification)
 	}
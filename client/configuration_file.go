@@ -0,0 +1,239 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Prefixes used to indicate the source of a secret.
+const (
+	// The secret is the value of an environment variable.
+	SecretPrefixEnv = "env:"
+	// The secret is the contents of a file.
+	SecretPrefixFile = "file:"
+	// The secret is the output of a command.
+	SecretPrefixCommand = "cmd:"
+)
+
+/*
+Loads the configuration from a JSON or YAML file, applies the overrides from the
+environment variables and calls Init().
+
+Files with the extensions ".yaml" and ".yml" are parsed as YAML, all others are
+parsed as JSON. Both formats use the same field names of the JSON
+representation of Configuration. Relative paths to the certificate files
+are resolved against the directory of the configuration file.
+
+The following environment variables, if set, will override the values from
+the file:
+
+  - IL_BASE_PATH: BasePath;
+  - IL_HOST: Host;
+  - IL_USER_AGENT: UserAgent;
+  - IL_NO_SERVER_VERIFICATION: NoServerVerification;
+  - IL_CERT_FILE: CertFile;
+  - IL_KEY_FILE: KeyFile;
+  - IL_KEY_PASSWORD: KeyPassword;
+  - IL_PFX_FILE: PFXFile;
+  - IL_PFX_PASSWORD: PFXPassword;
+  - IL_CA_FILE: CAFile;
+  - IL_CA_DIR: CADir;
+
+The PFXPassword and KeyPassword can be stored outside of the configuration by
+using one of the following forms:
+
+  - "env:<name>": The value of the environment variable <name>;
+  - "file:<path>": The contents of the file <path>, without the trailing line
+    break. Relative paths are resolved against the directory of the
+    configuration file;
+  - "cmd:<command> [args...]": The output of the command, without the trailing
+    line break. The arguments are separated by spaces;
+*/
+func LoadConfiguration(path string) (*Configuration, error) {
+	c, err := ReadConfiguration(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Init(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+/*
+Works like LoadConfiguration() but does not call Init(). It is useful when the
+configuration must be further modified before its initialization.
+*/
+func ReadConfiguration(path string) (*Configuration, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c := NewConfiguration()
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = unmarshalYAMLAsJSON(b, c)
+	default:
+		err = json.Unmarshal(b, c)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the configuration %s: %w", path, err)
+	}
+	baseDir := filepath.Dir(path)
+	c.CertFile = resolvePath(baseDir, c.CertFile)
+	c.KeyFile = resolvePath(baseDir, c.KeyFile)
+	c.PFXFile = resolvePath(baseDir, c.PFXFile)
+	c.PinnedCertFile = resolvePath(baseDir, c.PinnedCertFile)
+	c.CAFile = resolvePath(baseDir, c.CAFile)
+	c.CADir = resolvePath(baseDir, c.CADir)
+	c.CacheDir = resolvePath(baseDir, c.CacheDir)
+	if err := c.applyEnvironment(); err != nil {
+		return nil, err
+	}
+	if c.PFXPassword, err = ResolveSecret(baseDir, c.PFXPassword); err != nil {
+		return nil, fmt.Errorf("unable to resolve the PFX password: %w", err)
+	}
+	if c.KeyPassword, err = ResolveSecret(baseDir, c.KeyPassword); err != nil {
+		return nil, fmt.Errorf("unable to resolve the key password: %w", err)
+	}
+	return c, nil
+}
+
+/*
+Converts the YAML into JSON before unmarshalling it. This allows the reuse of
+the JSON tags of the target.
+*/
+func unmarshalYAMLAsJSON(b []byte, v any) error {
+	var tmp any
+	if err := yaml.Unmarshal(b, &tmp); err != nil {
+		return err
+	}
+	if tmp == nil {
+		return nil
+	}
+	j, err := json.Marshal(tmp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(j, v)
+}
+
+// Resolves the path against baseDir if it is relative.
+func resolvePath(baseDir string, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(baseDir, path)
+}
+
+// Applies the IL_* environment variables to this configuration.
+func (c *Configuration) applyEnvironment() error {
+	strs := map[string]*string{
+		"IL_BASE_PATH":    &c.BasePath,
+		"IL_HOST":         &c.Host,
+		"IL_USER_AGENT":   &c.UserAgent,
+		"IL_CERT_FILE":    &c.CertFile,
+		"IL_KEY_FILE":     &c.KeyFile,
+		"IL_KEY_PASSWORD": &c.KeyPassword,
+		"IL_PFX_FILE":     &c.PFXFile,
+		"IL_PFX_PASSWORD": &c.PFXPassword,
+		"IL_CA_FILE":      &c.CAFile,
+		"IL_CA_DIR":       &c.CADir,
+	}
+	for name, field := range strs {
+		if v, ok := os.LookupEnv(name); ok {
+			*field = v
+		}
+	}
+	if v, ok := os.LookupEnv("IL_NO_SERVER_VERIFICATION"); ok {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid value for IL_NO_SERVER_VERIFICATION: %w", err)
+		}
+		c.NoServerVerification = b
+	}
+	return nil
+}
+
+/*
+Resolves the secret according to its prefix (see LoadConfiguration()). Values
+without one of the known prefixes are returned as is. Relative files are
+resolved against baseDir.
+*/
+func ResolveSecret(baseDir string, value string) (string, error) {
+	switch {
+	case strings.HasPrefix(value, SecretPrefixEnv):
+		name := strings.TrimPrefix(value, SecretPrefixEnv)
+		v, ok := os.LookupEnv(name)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", name)
+		}
+		return v, nil
+	case strings.HasPrefix(value, SecretPrefixFile):
+		b, err := os.ReadFile(resolvePath(baseDir, strings.TrimPrefix(value, SecretPrefixFile)))
+		if err != nil {
+			return "", err
+		}
+		return trimLineBreak(string(b)), nil
+	case strings.HasPrefix(value, SecretPrefixCommand):
+		args := strings.Fields(strings.TrimPrefix(value, SecretPrefixCommand))
+		if len(args) == 0 {
+			return "", fmt.Errorf("empty secret command")
+		}
+		var stderr bytes.Buffer
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err != nil {
+			return "", fmt.Errorf("secret command failed: %w: %s", err,
+				strings.TrimSpace(stderr.String()))
+		}
+		return trimLineBreak(string(out)), nil
+	default:
+		return value, nil
+	}
+}
+
+// Removes a single trailing line break.
+func trimLineBreak(s string) string {
+	s = strings.TrimSuffix(s, "\n")
+	return strings.TrimSuffix(s, "\r")
+}
@@ -0,0 +1,222 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func copySampleFile(t *testing.T, dir string, name string) {
+	b, err := os.ReadFile(filepath.Join("..", "samples", name))
+	require.Nil(t, err)
+	require.Nil(t, os.WriteFile(filepath.Join(dir, name), b, 0600))
+}
+
+func TestLoadConfiguration_JSON(t *testing.T) {
+	dir := t.TempDir()
+	copySampleFile(t, dir, "cert.pem")
+	copySampleFile(t, dir, "key.pem")
+	file := filepath.Join(dir, "config.json")
+	require.Nil(t, os.WriteFile(file, []byte(`{
+		"basePath": "https://node:32032",
+		"certFile": "cert.pem",
+		"keyFile": "key.pem",
+		"noServerVerification": true
+	}`), 0600))
+
+	c, err := LoadConfiguration(file)
+	require.Nil(t, err)
+	assert.Equal(t, "https://node:32032", c.BasePath)
+	assert.Equal(t, filepath.Join(dir, "cert.pem"), c.CertFile)
+	assert.Equal(t, filepath.Join(dir, "key.pem"), c.KeyFile)
+	assert.True(t, c.NoServerVerification)
+	assert.Equal(t, "Swagger-Codegen/1.0.0/go", c.UserAgent)
+	assert.NotNil(t, c.HTTPClient)
+	assert.NotNil(t, c.ClientCertificates)
+}
+
+func TestLoadConfiguration_YAML(t *testing.T) {
+	dir := t.TempDir()
+	copySampleFile(t, dir, "sample.pfx")
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "password.txt"), []byte("password\n"), 0600))
+	file := filepath.Join(dir, "config.yaml")
+	require.Nil(t, os.WriteFile(file, []byte(
+		"basePath: https://node:32032\n"+
+			"pfxFile: sample.pfx\n"+
+			"pfxPassword: file:password.txt\n"+
+			"defaultHeader:\n"+
+			"  X-Test: value\n"), 0600))
+
+	c, err := LoadConfiguration(file)
+	require.Nil(t, err)
+	assert.Equal(t, "https://node:32032", c.BasePath)
+	assert.Equal(t, filepath.Join(dir, "sample.pfx"), c.PFXFile)
+	assert.Equal(t, "password", c.PFXPassword)
+	assert.Equal(t, "value", c.DefaultHeader["X-Test"])
+	assert.NotNil(t, c.ClientCertificates)
+}
+
+func TestReadConfiguration_Durations(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "config.json")
+	require.Nil(t, os.WriteFile(file, []byte(`{
+		"basePath": "https://node:32032",
+		"failoverCooldown": "1m30s",
+		"certReloadInterval": "5m",
+		"timeout": "30s",
+		"dialTimeout": "5s",
+		"responseHeaderTimeout": 2000000000
+	}`), 0600))
+	c, err := ReadConfiguration(file)
+	require.Nil(t, err)
+	assert.Equal(t, "https://node:32032", c.BasePath)
+	assert.Equal(t, 90*time.Second, c.FailoverCooldown)
+	assert.Equal(t, 5*time.Minute, c.CertReloadInterval)
+	assert.Equal(t, 30*time.Second, c.Timeout)
+	assert.Equal(t, 5*time.Second, c.DialTimeout)
+	assert.Equal(t, 2*time.Second, c.ResponseHeaderTimeout)
+	// Not in the file, thus the default is kept
+	assert.Equal(t, 10*time.Second, c.TLSHandshakeTimeout)
+	assert.NotNil(t, c.RetryPolicy)
+
+	file = filepath.Join(dir, "config.yaml")
+	require.Nil(t, os.WriteFile(file, []byte("failoverCooldown: 30s\ntimeout: 30s\ntlsHandshakeTimeout: 1s\n"), 0600))
+	c, err = ReadConfiguration(file)
+	require.Nil(t, err)
+	assert.Equal(t, 30*time.Second, c.FailoverCooldown)
+	assert.Equal(t, 30*time.Second, c.Timeout)
+	assert.Equal(t, time.Second, c.TLSHandshakeTimeout)
+
+	require.Nil(t, os.WriteFile(file, []byte("failoverCooldown: 1000000\n"), 0600))
+	c, err = ReadConfiguration(file)
+	require.Nil(t, err)
+	assert.Equal(t, time.Millisecond, c.FailoverCooldown)
+
+	require.Nil(t, os.WriteFile(file, []byte("failoverCooldown: 30 s\n"), 0600))
+	_, err = ReadConfiguration(file)
+	assert.ErrorContains(t, err, "invalid failoverCooldown")
+
+	require.Nil(t, os.WriteFile(file, []byte("timeout: true\n"), 0600))
+	_, err = ReadConfiguration(file)
+	assert.ErrorContains(t, err, "invalid timeout")
+
+	require.Nil(t, os.WriteFile(file, []byte("certReloadInterval: 1h\n"), 0600))
+	c, err = ReadConfiguration(file)
+	require.Nil(t, err)
+	assert.Equal(t, time.Hour, c.CertReloadInterval)
+}
+
+func TestLoadConfiguration_Environment(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "config.json")
+	require.Nil(t, os.WriteFile(file, []byte(`{"basePath": "https://node:32032"}`), 0600))
+	pfx, err := filepath.Abs(filepath.Join("..", "samples", "sample.pfx"))
+	require.Nil(t, err)
+
+	t.Setenv("IL_BASE_PATH", "https://other:32032")
+	t.Setenv("IL_PFX_FILE", pfx)
+	t.Setenv("IL_PFX_PASSWORD", "env:TEST_PFX_PASSWORD")
+	t.Setenv("TEST_PFX_PASSWORD", "password")
+	t.Setenv("IL_NO_SERVER_VERIFICATION", "true")
+
+	c, err := LoadConfiguration(file)
+	require.Nil(t, err)
+	assert.Equal(t, "https://other:32032", c.BasePath)
+	assert.Equal(t, pfx, c.PFXFile)
+	assert.Equal(t, "password", c.PFXPassword)
+	assert.True(t, c.NoServerVerification)
+
+	t.Setenv("IL_NO_SERVER_VERIFICATION", "X")
+	_, err = LoadConfiguration(file)
+	assert.ErrorContains(t, err, "IL_NO_SERVER_VERIFICATION")
+}
+
+func TestLoadConfiguration_Errors(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := LoadConfiguration(filepath.Join(dir, "none.json"))
+	assert.Error(t, err)
+
+	file := filepath.Join(dir, "config.json")
+	require.Nil(t, os.WriteFile(file, []byte(`{`), 0600))
+	_, err = LoadConfiguration(file)
+	assert.ErrorContains(t, err, "unable to parse the configuration")
+
+	// No certificate
+	require.Nil(t, os.WriteFile(file, []byte(`{}`), 0600))
+	_, err = LoadConfiguration(file)
+	assert.ErrorContains(t, err, "no client certificate set")
+
+	// Bad secret
+	require.Nil(t, os.WriteFile(file, []byte(`{"pfxPassword": "env:IL_TEST_UNDEFINED_VARIABLE"}`), 0600))
+	_, err = LoadConfiguration(file)
+	assert.ErrorContains(t, err, "unable to resolve the PFX password")
+}
+
+func TestResolveSecret(t *testing.T) {
+	dir := t.TempDir()
+
+	v, err := ResolveSecret(dir, "plain")
+	assert.Nil(t, err)
+	assert.Equal(t, "plain", v)
+
+	t.Setenv("IL_TEST_SECRET", "secret")
+	v, err = ResolveSecret(dir, "env:IL_TEST_SECRET")
+	assert.Nil(t, err)
+	assert.Equal(t, "secret", v)
+
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "secret"), []byte("secret\r\n"), 0600))
+	v, err = ResolveSecret(dir, "file:secret")
+	assert.Nil(t, err)
+	assert.Equal(t, "secret", v)
+
+	_, err = ResolveSecret(dir, "file:none")
+	assert.Error(t, err)
+
+	_, err = ResolveSecret(dir, "cmd:")
+	assert.Error(t, err)
+
+	if runtime.GOOS != "windows" {
+		v, err = ResolveSecret(dir, "cmd:echo secret")
+		assert.Nil(t, err)
+		assert.Equal(t, "secret", v)
+
+		_, err = ResolveSecret(dir, "cmd:false")
+		assert.ErrorContains(t, err, "secret command failed")
+	}
+}
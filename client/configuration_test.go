@@ -1,10 +1,28 @@
 package client
 
 import (
+	"context"
+	stdcrypto "crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"path"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/interlockledger/go-interlockledger-rest-client/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -112,6 +130,17 @@ func TestConfiguration_Init(t *testing.T) {
 	assert.NotNil(t, c.ClientCertificates)
 	assert.NotNil(t, c.CertPool)
 
+	// Encrypted PEM
+	c = NewConfiguration()
+	c.CertFile = path.Join("..", "samples", "cert.pem")
+	c.KeyFile = path.Join("..", "samples", "key-pkcs8-aes256.pem")
+	c.KeyPassword = "password"
+	assert.Nil(t, c.Init())
+	assert.NotNil(t, c.ClientCertificates)
+	c.ClientCertificates = nil
+	c.KeyPassword = "bad-password"
+	assert.ErrorIs(t, c.Init(), crypto.ErrIncorrectPassword)
+
 	// Ensure pfx over PEM
 	c = NewConfiguration()
 	c.CertFile = path.Join("..", "samples", "cert.pem2")
@@ -138,7 +167,205 @@ func TestConfiguration_Init(t *testing.T) {
 	assert.NotNil(t, c.ClientCertificates)
 	assert.NotNil(t, c.CertPool)
 
+	// Invalid cache directory
+	c = NewConfiguration()
+	c.ClientCertificates = clientCerts
+	c.CacheDir = filepath.Join(path.Join("..", "samples", "cert.pem"), "cache")
+	assert.ErrorContains(t, c.Init(), "unable to create the cache directory")
+
 	// None
 	c = NewConfiguration()
 	assert.ErrorContains(t, c.Init(), "no client certificate set")
 }
+
+func TestConfiguration_createTransport(t *testing.T) {
+	c := NewConfiguration()
+	tlsConfig := &tls.Config{}
+	transport, err := c.createTransport(tlsConfig)
+	require.Nil(t, err)
+	assert.Same(t, tlsConfig, transport.TLSClientConfig)
+	assert.NotNil(t, transport.Proxy)
+	assert.Equal(t, 10*time.Second, transport.TLSHandshakeTimeout)
+	assert.Zero(t, transport.ResponseHeaderTimeout)
+	assert.Zero(t, transport.MaxIdleConnsPerHost)
+	assert.False(t, transport.ForceAttemptHTTP2)
+
+	c.TLSHandshakeTimeout = time.Second
+	c.ResponseHeaderTimeout = 2 * time.Second
+	c.MaxIdleConnsPerHost = 20
+	c.EnableHTTP2 = true
+	c.ProxyURL = "http://proxy.example.com:3128"
+	transport, err = c.createTransport(tlsConfig)
+	require.Nil(t, err)
+	assert.Equal(t, time.Second, transport.TLSHandshakeTimeout)
+	assert.Equal(t, 2*time.Second, transport.ResponseHeaderTimeout)
+	assert.Equal(t, 20, transport.MaxIdleConnsPerHost)
+	assert.True(t, transport.ForceAttemptHTTP2)
+	req, _ := http.NewRequest(http.MethodGet, "https://node.example.com/", nil)
+	proxy, err := transport.Proxy(req)
+	require.Nil(t, err)
+	assert.Equal(t, "proxy.example.com:3128", proxy.Host)
+
+	c.DisableProxy = true
+	transport, err = c.createTransport(tlsConfig)
+	require.Nil(t, err)
+	assert.Nil(t, transport.Proxy)
+
+	c.DisableProxy = false
+	for _, p := range []string{"proxy.example.com", "http://%zz"} {
+		c.ProxyURL = p
+		_, err = c.createTransport(tlsConfig)
+		assert.ErrorContains(t, err, "invalid proxy URL")
+	}
+}
+
+func TestConfiguration_Init_Transport(t *testing.T) {
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(200 * time.Millisecond)
+		}
+		io.WriteString(w, "proxied "+r.URL.Host)
+	}))
+	defer proxy.Close()
+
+	c := NewConfiguration()
+	c.CertFile = path.Join("..", "samples", "cert.pem")
+	c.KeyFile = path.Join("..", "samples", "key.pem")
+	c.ProxyURL = proxy.URL
+	c.Timeout = 50 * time.Millisecond
+	require.Nil(t, c.Init())
+	assert.Equal(t, 50*time.Millisecond, c.HTTPClient.Timeout)
+
+	resp, err := c.HTTPClient.Get("http://node.example.com/")
+	require.Nil(t, err)
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.Nil(t, err)
+	assert.Equal(t, "proxied node.example.com", string(b))
+
+	_, err = c.HTTPClient.Get("http://node.example.com/slow")
+	assert.Error(t, err)
+}
+
+func writeServerCertificate(t *testing.T, file string, server *httptest.Server) {
+	require.Nil(t, os.WriteFile(file, pem.EncodeToMemory(&pem.Block{
+		Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600))
+}
+
+func TestConfiguration_createCertPool(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`"7.2.0"`))
+	}))
+	defer server.Close()
+	dir := t.TempDir()
+
+	// Only the system pool
+	c := NewConfiguration()
+	c.BasePath = server.URL
+	c.ClientCertificates = []tls.Certificate{}
+	require.Nil(t, c.Init())
+	_, _, err := NewAPIClient(c).NodeApi.ApiVersion(context.Background())
+	assert.Error(t, err)
+
+	// CAFile
+	caFile := filepath.Join(dir, "ca.pem")
+	writeServerCertificate(t, caFile, server)
+	c = NewConfiguration()
+	c.BasePath = server.URL
+	c.ClientCertificates = []tls.Certificate{}
+	c.CAFile = caFile
+	c.NoSystemCAs = true
+	require.Nil(t, c.Init())
+	_, _, err = NewAPIClient(c).NodeApi.ApiVersion(context.Background())
+	assert.Nil(t, err)
+
+	// CADir
+	caDir := filepath.Join(dir, "ca")
+	require.Nil(t, os.Mkdir(caDir, 0700))
+	require.Nil(t, os.Mkdir(filepath.Join(caDir, "sub.pem"), 0700))
+	require.Nil(t, os.WriteFile(filepath.Join(caDir, "README"), []byte("ignored"), 0600))
+	writeServerCertificate(t, filepath.Join(caDir, "server.crt"), server)
+	c = NewConfiguration()
+	c.BasePath = server.URL
+	c.ClientCertificates = []tls.Certificate{}
+	c.CADir = caDir
+	require.Nil(t, c.Init())
+	_, _, err = NewAPIClient(c).NodeApi.ApiVersion(context.Background())
+	assert.Nil(t, err)
+
+	// Errors
+	c = NewConfiguration()
+	c.CADir = filepath.Join(dir, "none")
+	_, err = c.createCertPool()
+	assert.Error(t, err)
+
+	c = NewConfiguration()
+	c.CAFile = path.Join("..", "samples", "key.pem")
+	_, err = c.createCertPool()
+	assert.ErrorContains(t, err, "unable to load the CA file")
+
+	c = NewConfiguration()
+	c.NoSystemCAs = true
+	pool, err := c.createCertPool()
+	assert.Nil(t, err)
+	assert.NotNil(t, pool)
+}
+
+// In-memory signer that hides the actual private key.
+type testSigner struct {
+	key stdcrypto.Signer
+}
+
+func (s *testSigner) Public() stdcrypto.PublicKey {
+	return s.key.Public()
+}
+
+func (s *testSigner) Sign(rand io.Reader, digest []byte, opts stdcrypto.SignerOpts) ([]byte, error) {
+	return s.key.Sign(rand, digest, opts)
+}
+
+func TestConfiguration_SetClientSigner(t *testing.T) {
+	var received []*x509.Certificate
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.TLS.PeerCertificates[0])
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`"7.2.0"`))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+
+	for _, key := range []stdcrypto.Signer{rsaKey, ecKey, edKey} {
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "signer"},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+		require.Nil(t, err)
+		cert, err := x509.ParseCertificate(der)
+		require.Nil(t, err)
+
+		c := NewConfiguration()
+		c.BasePath = server.URL
+		c.NoServerVerification = true
+		require.Nil(t, c.SetClientSigner([]*x509.Certificate{cert}, &testSigner{key}))
+		assert.NotNil(t, c.HTTPClient)
+		_, _, err = NewAPIClient(c).NodeApi.ApiVersion(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, cert.Raw, received[len(received)-1].Raw)
+	}
+
+	c := NewConfiguration()
+	assert.Error(t, c.SetClientSigner(nil, rsaKey))
+}
@@ -106,7 +106,7 @@ func (a *DocumentsApiService) DocumentsAddDocument(ctx context.Context, transact
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Documents_Add_Document", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -126,32 +126,7 @@ func (a *DocumentsApiService) DocumentsAddDocument(ctx context.Context, transact
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v models.DocumentsTransactionModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Documents_Add_Document", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -199,7 +174,7 @@ func (a *DocumentsApiService) DocumentsBeginTransaction(ctx context.Context, bod
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Documents_Begin_Transaction", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -219,32 +194,7 @@ func (a *DocumentsApiService) DocumentsBeginTransaction(ctx context.Context, bod
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 201:
-			var v models.DocumentsTransactionModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Documents_Begin_Transaction", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -291,7 +241,7 @@ func (a *DocumentsApiService) DocumentsCommitTransaction(ctx context.Context, tr
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Documents_Commit_Transaction", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -311,32 +261,7 @@ func (a *DocumentsApiService) DocumentsCommitTransaction(ctx context.Context, tr
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v string
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Documents_Commit_Transaction", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -386,7 +311,7 @@ func (a *DocumentsApiService) DocumentsGetAllDocuments(ctx context.Context, loca
 		return nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Documents_Get_AllDocuments", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarHttpResponse, err
 	}
@@ -403,23 +328,7 @@ func (a *DocumentsApiService) DocumentsGetAllDocuments(ctx context.Context, loca
 		return localVarHttpResponse, err
 	}
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarHttpResponse, newErr
-		default:
-			return localVarHttpResponse, newErr
-		}
+		return localVarHttpResponse, newAPIError("Documents_Get_AllDocuments", localVarHttpResponse, localVarBody)
 	}
 	return localVarHttpResponse, nil
 }
@@ -465,7 +374,7 @@ func (a *DocumentsApiService) DocumentsGetConfig(ctx context.Context) (models.Do
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Documents_Get_Config", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -485,32 +394,7 @@ func (a *DocumentsApiService) DocumentsGetConfig(ctx context.Context) (models.Do
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v models.DocumentUploadConfiguration
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Documents_Get_Config", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -557,7 +441,7 @@ func (a *DocumentsApiService) DocumentsGetMetadata(ctx context.Context, locator
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Documents_Get_Metadata", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -577,32 +461,7 @@ func (a *DocumentsApiService) DocumentsGetMetadata(ctx context.Context, locator
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v models.DocumentsMetadataModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Documents_Get_Metadata", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -653,7 +512,7 @@ func (a *DocumentsApiService) DocumentsGetSingleDocument(ctx context.Context, lo
 		return nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Documents_Get_SingleDocument", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarHttpResponse, err
 	}
@@ -669,23 +528,7 @@ func (a *DocumentsApiService) DocumentsGetSingleDocument(ctx context.Context, lo
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarHttpResponse, newErr
-		default:
-			return localVarHttpResponse, newErr
-		}
+		return localVarHttpResponse, newAPIError("Documents_Get_SingleDocument", localVarHttpResponse, localVarBody)
 	}
 	return localVarHttpResponse, nil
 }
@@ -732,7 +575,7 @@ func (a *DocumentsApiService) DocumentsGetTransactionStatus(ctx context.Context,
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Documents_Get_TransactionStatus", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -752,32 +595,7 @@ func (a *DocumentsApiService) DocumentsGetTransactionStatus(ctx context.Context,
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v models.DocumentsTransactionModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Documents_Get_TransactionStatus", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
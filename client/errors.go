@@ -35,6 +35,23 @@ import "errors"
 var (
 	// This error is used to report optimistic lock errors in some APIs. Those
 	// errors are usually associated with the return 409 when the parameter
-	// `lastChangedRecordSerial` is sent with the incorrect value.
+	// `lastChangedRecordSerial` is sent with the incorrect value. The APIError
+	// returned in such cases matches this error with errors.Is().
 	ErrOptimisticLockError = errors.New("optimistic lock failed")
+	// The requested resource was not found (404).
+	ErrNotFound = errors.New("not found")
+	// The client is not authenticated (401).
+	ErrUnauthorized = errors.New("unauthorized")
+	// The client is not allowed to perform the operation (403).
+	ErrForbidden = errors.New("forbidden")
+	// The request was rejected by the server validation (400 or 422).
+	ErrValidation = errors.New("validation failed")
+	// The request conflicts with the current state of the resource (409).
+	ErrConflict = errors.New("conflict")
+	// The server failed to process the request (5xx).
+	ErrServerError = errors.New("server error")
+	// The record has no payload.
+	ErrMissingPayload = errors.New("the record has no payload")
+	// The record reference belongs to a network other than the one of the node.
+	ErrNetworkMismatch = errors.New("network mismatch")
 )
@@ -0,0 +1,309 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/antihax/optional"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+)
+
+const (
+	// Default number of concurrent requests used by RecordApi.ExportChain().
+	DefaultExportWorkers = 4
+	// Default number of records fetched by each request of RecordApi.ExportChain().
+	DefaultExportWindowSize = 100
+)
+
+/*
+Options of RecordApi.ExportChain().
+*/
+type RecordApiExportOpts struct {
+	// If true, the records are exported as returned by RecordsListAsJson().
+	AsJson bool
+	// Serial of the first record to export.
+	FirstSerial int64
+	// Maximum number of concurrent requests. Defaults to DefaultExportWorkers.
+	Workers int
+	/*
+		Number of serials fetched by each worker at once. Defaults to
+		DefaultExportWindowSize.
+	*/
+	WindowSize int64
+	/*
+		Size of the pages used to fetch each window. If 0, the node default is
+		used.
+	*/
+	PageSize int32
+	// Function called after each window is written.
+	Progress func(ExportProgress)
+}
+
+/*
+Progress of RecordApi.ExportChain().
+*/
+type ExportProgress struct {
+	// Serial of the last record written.
+	Serial int64
+	// Serial of the last record that will be exported.
+	LastSerial int64
+	// Number of records written so far.
+	Records int64
+}
+
+// Records of a window of serials.
+type exportWindow struct {
+	lines [][]byte
+	last  int64
+	err   error
+}
+
+/*
+Exports the records of the chain into w as NDJSON, one record per line in
+the order of their serials. Each line is a models.RecordModel or, if
+options.AsJson is true, a models.RecordModelAsJson.
+
+The last record exported is the last record of the chain when the export
+starts, thus records added meanwhile are not exported. The serial range is
+split into windows of options.WindowSize serials that are fetched in parallel
+by up to options.Workers concurrent requests, while the output is still
+written in order. The export fails if the node does not return every serial
+of a window.
+
+It returns the number of records written. On error, the output contains all
+records before the failed window, thus the export can be resumed from the
+serial returned by LastExportedSerial(). See also ExportChainToFile().
+options may be nil.
+*/
+func (a *RecordApiService) ExportChain(ctx context.Context, chain string, w io.Writer,
+	options *RecordApiExportOpts) (int64, error) {
+	return exportChain(ctx, a.client.ChainApi, a, chain, w, options)
+}
+
+/*
+Exports the chain into the given file as described in ExportChain(). If the
+file already exists, it is treated as the output of a previous export that
+was interrupted: any incomplete last line is removed and the export resumes
+from the record that follows the last one in the file. In that case,
+options.FirstSerial is ignored.
+*/
+func (a *RecordApiService) ExportChainToFile(ctx context.Context, chain string, file string,
+	options *RecordApiExportOpts) (int64, error) {
+	f, err := os.OpenFile(file, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	serial, size, found, err := LastExportedSerial(f)
+	if err != nil {
+		return 0, err
+	}
+	if err := f.Truncate(size); err != nil {
+		return 0, err
+	}
+	if _, err := f.Seek(size, io.SeekStart); err != nil {
+		return 0, err
+	}
+	var opts RecordApiExportOpts
+	if options != nil {
+		opts = *options
+	}
+	if found {
+		opts.FirstSerial = serial + 1
+	}
+	w := bufio.NewWriter(f)
+	n, err := a.ExportChain(ctx, chain, w, &opts)
+	if ferr := w.Flush(); err == nil {
+		err = ferr
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	return n, err
+}
+
+/*
+Scans the output of a previous ExportChain() and returns the serial of the
+last complete record, the size of the data up to the end of its line and true.
+If there is no complete record, it returns false. An incomplete last line is
+ignored.
+*/
+func LastExportedSerial(r io.Reader) (int64, int64, bool, error) {
+	var (
+		serial int64
+		size   int64
+		found  bool
+	)
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		if err == io.EOF {
+			return serial, size, found, nil
+		} else if err != nil {
+			return 0, 0, false, err
+		}
+		var rec struct {
+			Serial int64 `json:"serial"`
+		}
+		if err := json.Unmarshal(line, &rec); err != nil {
+			return 0, 0, false, fmt.Errorf("invalid export line at offset %d: %w", size, err)
+		}
+		serial = rec.Serial
+		size += int64(len(line))
+		found = true
+	}
+}
+
+// Implements RecordApi.ExportChain() on top of the service interfaces.
+func exportChain(ctx context.Context, chainApi ChainAPI, recordApi RecordAPI, chain string,
+	w io.Writer, options *RecordApiExportOpts) (int64, error) {
+	var opts RecordApiExportOpts
+	if options != nil {
+		opts = *options
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = DefaultExportWorkers
+	}
+	if opts.WindowSize <= 0 {
+		opts.WindowSize = DefaultExportWindowSize
+	}
+	details, _, err := chainApi.ChainDetails(ctx, chain)
+	if err != nil {
+		return 0, err
+	}
+	last := details.LastRecord
+	if opts.FirstSerial > last {
+		return 0, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// Each started window holds a slot until it is written, thus there are at
+	// most opts.Workers windows being fetched or waiting to be written.
+	pending := make(chan chan exportWindow, opts.Workers-1)
+	go func() {
+		defer close(pending)
+		for first := opts.FirstSerial; first <= last; first += opts.WindowSize {
+			result := make(chan exportWindow, 1)
+			select {
+			case pending <- result:
+			case <-ctx.Done():
+				return
+			}
+			end := first + opts.WindowSize - 1
+			if end > last {
+				end = last
+			}
+			go func(first, end int64) {
+				result <- fetchExportWindow(ctx, recordApi, chain, first, end, &opts)
+			}(first, end)
+		}
+	}()
+
+	var written int64
+	for result := range pending {
+		var window exportWindow
+		select {
+		case window = <-result:
+		case <-ctx.Done():
+			return written, ctx.Err()
+		}
+		if window.err != nil {
+			return written, window.err
+		}
+		for _, line := range window.lines {
+			if _, err := w.Write(line); err != nil {
+				return written, err
+			}
+			written++
+		}
+		if opts.Progress != nil {
+			opts.Progress(ExportProgress{Serial: window.last, LastSerial: last, Records: written})
+		}
+	}
+	return written, ctx.Err()
+}
+
+// Fetches the records of a window and encodes them as NDJSON lines.
+func fetchExportWindow(ctx context.Context, recordApi RecordAPI, chain string, first, last int64,
+	opts *RecordApiExportOpts) exportWindow {
+	paging := RecordApiPagingOpts{}
+	if opts.PageSize > 0 {
+		paging.PageSize = optional.NewInt32(opts.PageSize)
+	}
+	if opts.AsJson {
+		return encodeExportWindow(NewRecordsListAsJsonPager(ctx, recordApi, chain,
+			&RecordApiRecordsListAsJsonOpts{RecordApiPagingOpts: paging,
+				FirstSerial: optional.NewInt64(first), LastSerial: optional.NewInt64(last)}),
+			first, last, func(r models.RecordModelAsJson) int64 { return r.Serial })
+	}
+	return encodeExportWindow(NewRecordsListPager(ctx, recordApi, chain,
+		&RecordApiRecordsListOpts{RecordApiPagingOpts: paging,
+			FirstSerial: optional.NewInt64(first), LastSerial: optional.NewInt64(last)}),
+		first, last, func(r models.RecordModel) int64 { return r.Serial })
+}
+
+/*
+Encodes all records of the pager. The window must contain all records from first
+to last, in the order of their serials, otherwise the export would silently miss
+records.
+*/
+func encodeExportWindow[T any](p *Pager[T], first, last int64, serial func(T) int64) exportWindow {
+	window := exportWindow{last: last}
+	next := first
+	for p.Next() {
+		item := p.Item()
+		s := serial(item)
+		if s != next || s > last {
+			return exportWindow{err: fmt.Errorf("unexpected record %d in the export window, expected %d", s, next)}
+		}
+		next++
+		var buff bytes.Buffer
+		if err := json.NewEncoder(&buff).Encode(item); err != nil {
+			return exportWindow{err: err}
+		}
+		window.lines = append(window.lines, buff.Bytes())
+	}
+	if err := p.Err(); err != nil {
+		return exportWindow{err: err}
+	}
+	if next <= last {
+		return exportWindow{err: fmt.Errorf("missing records %d to %d in the export window", next, last)}
+	}
+	return window
+}
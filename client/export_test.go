@@ -0,0 +1,299 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/interlockledger/go-interlockledger-rest-client/client"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/clienttest"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newExportNode(t *testing.T, records int) (*clienttest.Node, *client.APIClient, string) {
+	node := clienttest.NewNode(nil)
+	t.Cleanup(node.Close)
+	chain := node.CreateChain("chain")
+	for i := 1; i < records; i++ {
+		node.AddJSONRecord(chain, 1, 300, map[string]any{"i": i})
+	}
+	return node, node.NewClient(), chain
+}
+
+func exportedSerials(t *testing.T, data []byte, asJson bool) []int64 {
+	var ret []int64
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for s.Scan() {
+		if asJson {
+			var rec models.RecordModelAsJson
+			require.Nil(t, json.Unmarshal(s.Bytes(), &rec))
+			ret = append(ret, rec.Serial)
+		} else {
+			var rec models.RecordModel
+			require.Nil(t, json.Unmarshal(s.Bytes(), &rec))
+			ret = append(ret, rec.Serial)
+		}
+	}
+	return ret
+}
+
+func serialRange(first, last int64) []int64 {
+	var ret []int64
+	for s := first; s <= last; s++ {
+		ret = append(ret, s)
+	}
+	return ret
+}
+
+func TestRecordApiService_ExportChain(t *testing.T) {
+	node, c, chain := newExportNode(t, 57)
+	ctx := context.Background()
+
+	var mutex sync.Mutex
+	var inFlight, maxInFlight int32
+	added := false
+	node.SetErrorHook(func(operation string, request *http.Request) int {
+		if operation != "Records_List" {
+			return 0
+		}
+		mutex.Lock()
+		// Records added after the start are not exported
+		if !added {
+			node.AddRecord(chain, 1, []byte{0xF8, 0x34, 0x00})
+			added = true
+		}
+		mutex.Unlock()
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		return 0
+	})
+
+	var progress []client.ExportProgress
+	var buff bytes.Buffer
+	n, err := c.RecordApi.ExportChain(ctx, chain, &buff, &client.RecordApiExportOpts{
+		Workers:    3,
+		WindowSize: 10,
+		PageSize:   4,
+		Progress: func(p client.ExportProgress) {
+			progress = append(progress, p)
+		},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, int64(57), n)
+	assert.Equal(t, serialRange(0, 56), exportedSerials(t, buff.Bytes(), false))
+	require.Len(t, progress, 6)
+	assert.Equal(t, client.ExportProgress{Serial: 9, LastSerial: 56, Records: 10}, progress[0])
+	assert.Equal(t, client.ExportProgress{Serial: 56, LastSerial: 56, Records: 57}, progress[5])
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(3))
+	assert.Greater(t, atomic.LoadInt32(&maxInFlight), int32(1))
+	assert.Len(t, node.Records(chain), 58)
+	node.SetErrorHook(nil)
+
+	// JSON and partial
+	buff.Reset()
+	n, err = c.RecordApi.ExportChain(ctx, chain, &buff, &client.RecordApiExportOpts{
+		AsJson:      true,
+		FirstSerial: 50,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, int64(8), n)
+	assert.Equal(t, serialRange(50, 57), exportedSerials(t, buff.Bytes(), true))
+	assert.Contains(t, buff.String(), `"payload":{"i":50}`)
+
+	// Nothing to export
+	buff.Reset()
+	n, err = c.RecordApi.ExportChain(ctx, chain, &buff, &client.RecordApiExportOpts{FirstSerial: 100})
+	require.Nil(t, err)
+	assert.Equal(t, int64(0), n)
+	assert.Equal(t, 0, buff.Len())
+}
+
+func TestRecordApiService_ExportChain_Errors(t *testing.T) {
+	node, c, chain := newExportNode(t, 30)
+	ctx := context.Background()
+
+	var calls int32
+	node.SetErrorHook(func(operation string, request *http.Request) int {
+		if operation == "Records_List" && atomic.AddInt32(&calls, 1) == 2 {
+			return http.StatusInternalServerError
+		}
+		return 0
+	})
+	var buff bytes.Buffer
+	n, err := c.RecordApi.ExportChain(ctx, chain, &buff, &client.RecordApiExportOpts{
+		Workers:    1,
+		WindowSize: 10,
+	})
+	assert.ErrorIs(t, err, client.ErrServerError)
+	assert.Equal(t, int64(10), n)
+	assert.Equal(t, serialRange(0, 9), exportedSerials(t, buff.Bytes(), false))
+	node.SetErrorHook(nil)
+
+	node.FailNext("Chain_Details", http.StatusNotFound, 1)
+	_, err = c.RecordApi.ExportChain(ctx, chain, &buff, nil)
+	assert.ErrorIs(t, err, client.ErrNotFound)
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = c.RecordApi.ExportChain(cctx, chain, &buff, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestRecordApiService_ExportChain_MissingRecords(t *testing.T) {
+	_, c, chain := newExportNode(t, 30)
+	ctx := context.Background()
+
+	// Removes the records 5 and 29 from the pages returned by the node
+	c.Use(func(next client.Handler) client.Handler {
+		return func(operation string, request *http.Request) (*http.Response, error) {
+			resp, err := next(operation, request)
+			if err != nil || operation != "Records_List" {
+				return resp, err
+			}
+			var page map[string]any
+			err = json.NewDecoder(resp.Body).Decode(&page)
+			resp.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			var items []any
+			for _, item := range page["items"].([]any) {
+				// The serial 0 is omitted
+				serial, _ := item.(map[string]any)["serial"].(float64)
+				if serial != 5 && serial != 29 {
+					items = append(items, item)
+				}
+			}
+			page["items"] = items
+			b, err := json.Marshal(page)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(b))
+			resp.ContentLength = int64(len(b))
+			return resp, nil
+		}
+	})
+
+	var buff bytes.Buffer
+	n, err := c.RecordApi.ExportChain(ctx, chain, &buff, &client.RecordApiExportOpts{
+		Workers:    1,
+		WindowSize: 10,
+	})
+	assert.ErrorContains(t, err, "unexpected record 6 in the export window, expected 5")
+	assert.Equal(t, int64(0), n)
+
+	// The gap at the end of the window
+	n, err = c.RecordApi.ExportChain(ctx, chain, &buff, &client.RecordApiExportOpts{
+		Workers:     1,
+		WindowSize:  10,
+		FirstSerial: 10,
+	})
+	assert.ErrorContains(t, err, "missing records 29 to 29 in the export window")
+	assert.Equal(t, int64(10), n)
+}
+
+func TestRecordApiService_ExportChainToFile(t *testing.T) {
+	_, c, chain := newExportNode(t, 25)
+	ctx := context.Background()
+	file := filepath.Join(t.TempDir(), "export.ndjson")
+
+	// Interrupted export with an incomplete last line
+	var buff bytes.Buffer
+	_, err := c.RecordApi.ExportChain(ctx, chain, &buff, nil)
+	require.Nil(t, err)
+	lines := strings.SplitAfter(buff.String(), "\n")
+	partial := strings.Join(lines[:7], "") + lines[7][:10]
+	require.Nil(t, os.WriteFile(file, []byte(partial), 0600))
+
+	opts := &client.RecordApiExportOpts{WindowSize: 5, FirstSerial: 20}
+	n, err := c.RecordApi.ExportChainToFile(ctx, chain, file, opts)
+	require.Nil(t, err)
+	assert.Equal(t, int64(18), n)
+	data, err := os.ReadFile(file)
+	require.Nil(t, err)
+	assert.Equal(t, buff.String(), string(data))
+
+	// Already complete
+	n, err = c.RecordApi.ExportChainToFile(ctx, chain, file, opts)
+	require.Nil(t, err)
+	assert.Equal(t, int64(0), n)
+
+	// New file
+	file = filepath.Join(t.TempDir(), "export2.ndjson")
+	n, err = c.RecordApi.ExportChainToFile(ctx, chain, file, opts)
+	require.Nil(t, err)
+	assert.Equal(t, int64(5), n)
+	data, err = os.ReadFile(file)
+	require.Nil(t, err)
+	assert.Equal(t, serialRange(20, 24), exportedSerials(t, data, false))
+
+	// Invalid file
+	require.Nil(t, os.WriteFile(file, []byte("{}\nxx\n"), 0600))
+	_, err = c.RecordApi.ExportChainToFile(ctx, chain, file, opts)
+	assert.ErrorContains(t, err, "invalid export line at offset 3")
+}
+
+func TestLastExportedSerial(t *testing.T) {
+	serial, size, found, err := client.LastExportedSerial(strings.NewReader(""))
+	require.Nil(t, err)
+	assert.False(t, found)
+	assert.Equal(t, int64(0), serial)
+	assert.Equal(t, int64(0), size)
+
+	serial, size, found, err = client.LastExportedSerial(strings.NewReader(`{"a":1}` + "\n" +
+		`{"serial":2}` + "\n" + `{"seri`))
+	require.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, int64(2), serial)
+	assert.Equal(t, int64(21), size)
+}
@@ -0,0 +1,313 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default time an endpoint remains out of the rotation after a failure.
+const DefaultFailoverCooldown = 30 * time.Second
+
+// Default interval used by APIClient.StartHealthChecks().
+const DefaultHealthCheckInterval = 30 * time.Second
+
+// Status of an endpoint used by the client.
+type EndpointStatus struct {
+	// The base URL of the endpoint.
+	URL string
+	// True if this is the primary endpoint (Configuration.BasePath).
+	Primary bool
+	// True if the last request sent to this endpoint succeeded.
+	Healthy bool
+	// The error of the last failed request, if any.
+	LastError error
+	// Time of the last request sent to this endpoint.
+	LastCheck time.Time
+}
+
+// Endpoint of the failover router.
+type endpoint struct {
+	url       *url.URL
+	healthy   bool
+	failedAt  time.Time
+	lastError error
+	lastCheck time.Time
+}
+
+/*
+This router distributes the requests among the endpoints. Idempotent requests
+are sent to the healthy endpoints in a round-robin fashion and fail over to the
+next one on connection errors or 5xx responses. All other requests are always
+sent to the primary endpoint.
+*/
+type failoverRouter struct {
+	mu sync.Mutex
+	// The base URL used to build the requests. It is also the primary endpoint.
+	base *url.URL
+	// The list of endpoints. The first one is the primary.
+	endpoints []*endpoint
+	// Index of the next endpoint to be used by reads.
+	next int
+	// Time an endpoint remains out of the rotation after a failure.
+	cooldown time.Duration
+}
+
+type endpointKey struct{}
+
+// Parses the endpoint URL.
+func parseEndpoint(s string) (*url.URL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint %q", s)
+	}
+	return u, nil
+}
+
+// Creates a new router. The base path will be used as the primary endpoint.
+func newFailoverRouter(basePath string, endpoints []string, cooldown time.Duration) (*failoverRouter, error) {
+	base, err := parseEndpoint(basePath)
+	if err != nil {
+		return nil, err
+	}
+	if cooldown <= 0 {
+		cooldown = DefaultFailoverCooldown
+	}
+	r := &failoverRouter{
+		base:      base,
+		endpoints: []*endpoint{{url: base, healthy: true}},
+		cooldown:  cooldown,
+	}
+	for _, s := range endpoints {
+		u, err := parseEndpoint(s)
+		if err != nil {
+			return nil, err
+		}
+		r.endpoints = append(r.endpoints, &endpoint{url: u, healthy: true})
+	}
+	return r, nil
+}
+
+// Returns true if the endpoint can receive reads.
+func (r *failoverRouter) available(e *endpoint, now time.Time) bool {
+	return e.healthy || now.Sub(e.failedAt) >= r.cooldown
+}
+
+/*
+Returns the indexes of the endpoints that may receive the request, in the order
+they must be tried.
+*/
+func (r *failoverRouter) candidates(request *http.Request) []int {
+	if i, ok := request.Context().Value(endpointKey{}).(int); ok {
+		return []int{i}
+	}
+	if !isIdempotent(request.Method) {
+		return []int{0}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	n := len(r.endpoints)
+	start := r.next
+	r.next = (r.next + 1) % n
+	ret := make([]int, 0, n)
+	var unavailable []int
+	for i := 0; i < n; i++ {
+		idx := (start + i) % n
+		if r.available(r.endpoints[idx], now) {
+			ret = append(ret, idx)
+		} else {
+			unavailable = append(unavailable, idx)
+		}
+	}
+	// The unavailable endpoints are the last resort.
+	return append(ret, unavailable...)
+}
+
+// Updates the status of the endpoint.
+func (r *failoverRouter) update(idx int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e := r.endpoints[idx]
+	e.lastCheck = time.Now()
+	e.lastError = err
+	if err != nil {
+		e.healthy = false
+		e.failedAt = e.lastCheck
+	} else {
+		e.healthy = true
+	}
+}
+
+// Returns a copy of the request that targets the given endpoint.
+func (r *failoverRouter) rewrite(request *http.Request, idx int) (*http.Request, error) {
+	target := r.endpoints[idx].url
+	ret, err := rewindRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	rel := strings.TrimPrefix(request.URL.EscapedPath(), strings.TrimSuffix(r.base.EscapedPath(), "/"))
+	u, err := url.Parse(strings.TrimSuffix(target.String(), "/") + rel)
+	if err != nil {
+		return nil, err
+	}
+	u.RawQuery = request.URL.RawQuery
+	ret.URL = u
+	ret.Host = ""
+	return ret, nil
+}
+
+// Sends the request to the first endpoint that is able to handle it.
+func (r *failoverRouter) do(client *http.Client, request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+	candidates := r.candidates(request)
+	for i, idx := range candidates {
+		req := request
+		if idx != 0 {
+			var err error
+			if req, err = r.rewrite(request, idx); err != nil {
+				return nil, err
+			}
+		} else if i > 0 {
+			var err error
+			if req, err = rewindRequest(request); err != nil {
+				return nil, err
+			}
+		}
+		last := i == len(candidates)-1
+		resp, err := client.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return resp, err
+			}
+			r.update(idx, err)
+			if last {
+				return resp, err
+			}
+			continue
+		}
+		if resp.StatusCode >= 500 {
+			r.update(idx, fmt.Errorf("%s", resp.Status))
+			if last {
+				return resp, nil
+			}
+			resp.Body.Close()
+			continue
+		}
+		r.update(idx, nil)
+		return resp, nil
+	}
+	return nil, fmt.Errorf("no endpoint available")
+}
+
+// Returns the status of all endpoints.
+func (r *failoverRouter) status() []EndpointStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ret := make([]EndpointStatus, len(r.endpoints))
+	for i, e := range r.endpoints {
+		ret[i] = EndpointStatus{
+			URL:       e.url.String(),
+			Primary:   i == 0,
+			Healthy:   e.healthy,
+			LastError: e.lastError,
+			LastCheck: e.lastCheck,
+		}
+	}
+	return ret
+}
+
+/*
+Returns the status of the endpoints used by this client. The first one is always
+the primary. It returns nil if Configuration.Endpoints is not set.
+*/
+func (c *APIClient) Endpoints() []EndpointStatus {
+	if c.router == nil {
+		return nil
+	}
+	return c.router.status()
+}
+
+/*
+Checks the health of all endpoints by calling NodeApi.ApiVersion() on each one
+of them. The results can be retrieved by Endpoints().
+
+It does nothing if Configuration.Endpoints is not set.
+*/
+func (c *APIClient) CheckEndpoints(ctx context.Context) {
+	if c.router == nil {
+		return
+	}
+	ctx = WithRetryPolicy(ctx, nil)
+	var wg sync.WaitGroup
+	for i := range c.router.endpoints {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c.NodeApi.ApiVersion(context.WithValue(ctx, endpointKey{}, i))
+		}(i)
+	}
+	wg.Wait()
+}
+
+/*
+Starts a goroutine that calls CheckEndpoints() periodically until ctx is done.
+If interval is not positive, DefaultHealthCheckInterval is used.
+*/
+func (c *APIClient) StartHealthChecks(ctx context.Context, interval time.Duration) {
+	if c.router == nil {
+		return
+	}
+	if interval <= 0 {
+		interval = DefaultHealthCheckInterval
+	}
+	go func() {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			c.CheckEndpoints(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+		}
+	}()
+}
@@ -0,0 +1,197 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failoverTestNode struct {
+	server *httptest.Server
+	count  int32
+	status int32
+}
+
+func newFailoverTestNode(t *testing.T, name string) *failoverTestNode {
+	n := &failoverTestNode{status: 200}
+	n.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&n.count, 1)
+		status := int(atomic.LoadInt32(&n.status))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		switch r.URL.Path {
+		case "/records@chain":
+			if r.Method == http.MethodPost {
+				w.Write([]byte(`{"chainId":"` + name + `"}`))
+			} else {
+				w.Write([]byte(`{"items":[{"chainId":"` + name + `"}]}`))
+			}
+		default:
+			w.Write([]byte(`"` + name + `"`))
+		}
+	}))
+	t.Cleanup(n.server.Close)
+	return n
+}
+
+func newFailoverTestClient(t *testing.T, nodes ...*failoverTestNode) *APIClient {
+	cfg := NewConfiguration()
+	cfg.BasePath = nodes[0].server.URL
+	for _, n := range nodes[1:] {
+		cfg.Endpoints = append(cfg.Endpoints, n.server.URL)
+	}
+	cfg.RetryPolicy = nil
+	return NewAPIClient(cfg)
+}
+
+func TestNewFailoverRouter(t *testing.T) {
+	r, err := newFailoverRouter("https://a:1/base", []string{"https://b:2"}, 0)
+	require.Nil(t, err)
+	assert.Equal(t, DefaultFailoverCooldown, r.cooldown)
+	assert.Len(t, r.endpoints, 2)
+
+	_, err = newFailoverRouter("/", []string{"https://b:2"}, 0)
+	assert.Error(t, err)
+	_, err = newFailoverRouter("https://a:1", []string{"b"}, 0)
+	assert.Error(t, err)
+
+	c := NewConfiguration()
+	c.Endpoints = []string{"b"}
+	assert.ErrorContains(t, c.Init(), "invalid endpoint")
+}
+
+func TestFailoverRouter_rewrite(t *testing.T) {
+	r, err := newFailoverRouter("https://a:1/base", []string{"https://b:2/other/"}, 0)
+	require.Nil(t, err)
+	req, err := http.NewRequest("GET", "https://a:1/base/records@chain%2F1?page=1", nil)
+	require.Nil(t, err)
+	req.Host = "a"
+	ret, err := r.rewrite(req, 1)
+	require.Nil(t, err)
+	assert.Equal(t, "https://b:2/other/records@chain%2F1?page=1", ret.URL.String())
+	assert.Equal(t, "", ret.Host)
+}
+
+func TestAPIClient_Failover(t *testing.T) {
+	a := newFailoverTestNode(t, "a")
+	b := newFailoverTestNode(t, "b")
+	c := newFailoverTestClient(t, a, b)
+
+	// Reads are distributed
+	seen := map[string]bool{}
+	for i := 0; i < 4; i++ {
+		v, _, err := c.NodeApi.ApiVersion(context.Background())
+		require.Nil(t, err)
+		seen[v] = true
+	}
+	assert.Equal(t, map[string]bool{"a": true, "b": true}, seen)
+
+	// Fail over on 5xx
+	atomic.StoreInt32(&a.status, 503)
+	for i := 0; i < 4; i++ {
+		p, _, err := c.RecordApi.RecordsList(context.Background(), "chain", nil)
+		require.Nil(t, err)
+		assert.Equal(t, "b", p.Items[0].ChainId)
+	}
+	s := c.Endpoints()
+	require.Len(t, s, 2)
+	assert.True(t, s[0].Primary)
+	assert.False(t, s[0].Healthy)
+	assert.Error(t, s[0].LastError)
+	assert.True(t, s[1].Healthy)
+
+	// Writes are pinned to the primary
+	atomic.StoreInt32(&b.count, 0)
+	_, resp, err := c.RecordApi.RecordAdd(context.Background(), "chain", &models.NewRecordModel{})
+	assert.ErrorIs(t, err, ErrServerError)
+	assert.Equal(t, 503, resp.StatusCode)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&b.count))
+
+	// Connection errors
+	atomic.StoreInt32(&a.status, 200)
+	b.server.Close()
+	for i := 0; i < 4; i++ {
+		v, _, err := c.NodeApi.ApiVersion(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, "a", v)
+	}
+}
+
+func TestAPIClient_CheckEndpoints(t *testing.T) {
+	a := newFailoverTestNode(t, "a")
+	b := newFailoverTestNode(t, "b")
+	c := newFailoverTestClient(t, a, b)
+
+	atomic.StoreInt32(&b.status, 502)
+	c.CheckEndpoints(context.Background())
+	s := c.Endpoints()
+	assert.True(t, s[0].Healthy)
+	assert.False(t, s[1].Healthy)
+	assert.False(t, s[1].LastCheck.IsZero())
+
+	atomic.StoreInt32(&b.status, 200)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c.StartHealthChecks(ctx, time.Millisecond)
+	assert.Eventually(t, func() bool {
+		return c.Endpoints()[1].Healthy
+	}, time.Second, time.Millisecond)
+
+	// Invalid intervals use the default instead of panicking.
+	atomic.StoreInt32(&b.status, 502)
+	c.StartHealthChecks(ctx, 0)
+	assert.Eventually(t, func() bool {
+		return !c.Endpoints()[1].Healthy
+	}, time.Second, time.Millisecond)
+}
+
+func TestAPIClient_NoEndpoints(t *testing.T) {
+	a := newFailoverTestNode(t, "a")
+	c := newFailoverTestClient(t, a)
+	assert.Nil(t, c.Endpoints())
+	c.CheckEndpoints(context.Background())
+	c.StartHealthChecks(context.Background(), time.Millisecond)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&a.count))
+
+	c.cfg.Endpoints = []string{"bad"}
+	c.ChangeBasePath(a.server.URL)
+	_, _, err := c.NodeApi.ApiVersion(context.Background())
+	assert.ErrorContains(t, err, "invalid endpoint")
+}
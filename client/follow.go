@@ -0,0 +1,304 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/antihax/optional"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+)
+
+const (
+	// Default minimum interval between polls used by RecordApi.Follow().
+	DefaultFollowMinPollInterval = time.Second
+	// Default maximum interval between polls used by RecordApi.Follow().
+	DefaultFollowMaxPollInterval = 30 * time.Second
+)
+
+/*
+Options of RecordApi.Follow().
+*/
+type RecordApiFollowOpts struct {
+	// If true, the records are fetched using RecordsListAsJson().
+	AsJson bool
+	// Size of the pages used to fetch the records. If 0, the node default is used.
+	PageSize int32
+	/*
+		Interval between polls when new records are being found. Defaults to
+		DefaultFollowMinPollInterval.
+	*/
+	MinPollInterval time.Duration
+	/*
+		Maximum interval between polls. The interval doubles after each poll that
+		finds no new record or fails, up to this value. Defaults to
+		DefaultFollowMaxPollInterval.
+	*/
+	MaxPollInterval time.Duration
+	/*
+		Store used to save the serial of the last processed record. If set, each
+		event must be committed with RecordEvent.Commit() before the next one is
+		delivered.
+	*/
+	Checkpoints CheckpointStore
+	// Key of the checkpoint inside Checkpoints. Defaults to the chain ID.
+	CheckpointKey string
+}
+
+/*
+Event delivered by RecordApi.Follow(). It carries either a record or an error.
+*/
+type RecordEvent struct {
+	// Serial of the record.
+	Serial int64
+	// The record. It is set unless RecordApiFollowOpts.AsJson is true.
+	Record *models.RecordModel
+	// The record as JSON. It is set if RecordApiFollowOpts.AsJson is true.
+	JsonRecord *models.RecordModelAsJson
+	/*
+		Error found while following the chain. Errors are not fatal, the chain
+		will be polled again after the poll interval.
+	*/
+	Err error
+	// Function that commits this event.
+	commit func() error
+}
+
+/*
+Marks the record as processed by saving its serial into the checkpoint store.
+It must be called after the record has been processed. It does nothing if
+there is no checkpoint store or if the event is an error.
+*/
+func (e RecordEvent) Commit() error {
+	if e.commit == nil {
+		return nil
+	}
+	return e.commit()
+}
+
+/*
+Follows the given chain, delivering each new record as it is added. The first
+record delivered is fromSerial, unless there is a checkpoint for this chain in
+options.Checkpoints. In that case, it resumes from the record that follows the
+checkpoint.
+
+The chain is followed by polling ChainApi.ChainDetails() and fetching the new
+records with RecordsList() or RecordsListAsJson() using FirstSerial and
+LastSerial. The poll interval adapts to the activity of the chain: it is
+reset to MinPollInterval when new records are found and doubles, up to
+MaxPollInterval, when none is found.
+
+When a checkpoint store is used, the next record is only delivered after the
+previous one is committed with RecordEvent.Commit(). As the checkpoint always
+points to the last committed record, each record is delivered at least once
+across restarts: a record processed but not committed before a crash is
+delivered again. Thus the processing of the records must be idempotent.
+
+The returned channel is closed when ctx is canceled or when the checkpoint
+cannot be loaded. options may be nil.
+*/
+func (a *RecordApiService) Follow(ctx context.Context, chain string, fromSerial int64,
+	options *RecordApiFollowOpts) <-chan RecordEvent {
+	return follow(ctx, a.client.ChainApi, a, chain, fromSerial, options)
+}
+
+// State of a RecordApi.Follow() call.
+type follower struct {
+	chainApi  ChainAPI
+	recordApi RecordAPI
+	chain     string
+	opts      RecordApiFollowOpts
+	events    chan RecordEvent
+	next      int64
+}
+
+// Implements RecordApi.Follow() on top of the service interfaces.
+func follow(ctx context.Context, chainApi ChainAPI, recordApi RecordAPI, chain string,
+	fromSerial int64, options *RecordApiFollowOpts) <-chan RecordEvent {
+	f := &follower{
+		chainApi:  chainApi,
+		recordApi: recordApi,
+		chain:     chain,
+		events:    make(chan RecordEvent),
+		next:      fromSerial,
+	}
+	if options != nil {
+		f.opts = *options
+	}
+	if f.opts.MinPollInterval <= 0 {
+		f.opts.MinPollInterval = DefaultFollowMinPollInterval
+	}
+	if f.opts.MaxPollInterval <= 0 {
+		f.opts.MaxPollInterval = DefaultFollowMaxPollInterval
+	}
+	if f.opts.MaxPollInterval < f.opts.MinPollInterval {
+		f.opts.MaxPollInterval = f.opts.MinPollInterval
+	}
+	if f.opts.CheckpointKey == "" {
+		f.opts.CheckpointKey = chain
+	}
+	go f.run(ctx)
+	return f.events
+}
+
+// Main loop of the follower.
+func (f *follower) run(ctx context.Context) {
+	defer close(f.events)
+	if f.opts.Checkpoints != nil {
+		serial, ok, err := f.opts.Checkpoints.Load(ctx, f.opts.CheckpointKey)
+		if err != nil {
+			f.send(ctx, RecordEvent{Err: err})
+			return
+		}
+		if ok {
+			f.next = serial + 1
+		}
+	}
+	interval := f.opts.MinPollInterval
+	for {
+		found, err := f.poll(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil && !f.send(ctx, RecordEvent{Err: err}) {
+			return
+		}
+		if found {
+			interval = f.opts.MinPollInterval
+		} else {
+			interval *= 2
+			if interval > f.opts.MaxPollInterval {
+				interval = f.opts.MaxPollInterval
+			}
+		}
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+/*
+Delivers all records added since the last poll. It returns true if at least
+one record was delivered.
+*/
+func (f *follower) poll(ctx context.Context) (bool, error) {
+	details, _, err := f.chainApi.ChainDetails(ctx, f.chain)
+	if err != nil {
+		return false, err
+	}
+	if details.LastRecord < f.next {
+		return false, nil
+	}
+	paging := RecordApiPagingOpts{}
+	if f.opts.PageSize > 0 {
+		paging.PageSize = optional.NewInt32(f.opts.PageSize)
+	}
+	first := optional.NewInt64(f.next)
+	last := optional.NewInt64(details.LastRecord)
+	if f.opts.AsJson {
+		return deliverAll(ctx, f, NewRecordsListAsJsonPager(ctx, f.recordApi, f.chain,
+			&RecordApiRecordsListAsJsonOpts{RecordApiPagingOpts: paging, FirstSerial: first, LastSerial: last}),
+			func(r models.RecordModelAsJson) RecordEvent {
+				return RecordEvent{Serial: r.Serial, JsonRecord: &r}
+			})
+	}
+	return deliverAll(ctx, f, NewRecordsListPager(ctx, f.recordApi, f.chain,
+		&RecordApiRecordsListOpts{RecordApiPagingOpts: paging, FirstSerial: first, LastSerial: last}),
+		func(r models.RecordModel) RecordEvent {
+			return RecordEvent{Serial: r.Serial, Record: &r}
+		})
+}
+
+// Delivers all items of the pager.
+func deliverAll[T any](ctx context.Context, f *follower, p *Pager[T], event func(T) RecordEvent) (bool, error) {
+	found := false
+	for p.Next() {
+		delivered, err := f.deliver(ctx, event(p.Item()))
+		if err != nil {
+			return found, err
+		}
+		found = found || delivered
+	}
+	return found, p.Err()
+}
+
+/*
+Delivers a single event and waits for its commit if required. Records that
+were already delivered are skipped.
+*/
+func (f *follower) deliver(ctx context.Context, event RecordEvent) (bool, error) {
+	if event.Serial < f.next {
+		return false, nil
+	}
+	var committed chan struct{}
+	if store := f.opts.Checkpoints; store != nil {
+		committed = make(chan struct{})
+		var once sync.Once
+		key, serial := f.opts.CheckpointKey, event.Serial
+		// The commit must succeed even if ctx is canceled meanwhile.
+		commitCtx := context.WithoutCancel(ctx)
+		event.commit = func() error {
+			if err := store.Save(commitCtx, key, serial); err != nil {
+				return err
+			}
+			once.Do(func() { close(committed) })
+			return nil
+		}
+	}
+	if !f.send(ctx, event) {
+		return false, ctx.Err()
+	}
+	if committed != nil {
+		select {
+		case <-committed:
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+	}
+	f.next = event.Serial + 1
+	return true, nil
+}
+
+// Sends the event unless ctx is canceled.
+func (f *follower) send(ctx context.Context, event RecordEvent) bool {
+	select {
+	case f.events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,211 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/interlockledger/go-interlockledger-rest-client/client"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/clienttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func receiveEvent(t *testing.T, events <-chan client.RecordEvent) client.RecordEvent {
+	t.Helper()
+	select {
+	case ev, ok := <-events:
+		require.True(t, ok, "channel closed")
+		return ev
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timeout")
+	}
+	return client.RecordEvent{}
+}
+
+func assertNoEvent(t *testing.T, events <-chan client.RecordEvent) {
+	t.Helper()
+	select {
+	case ev := <-events:
+		assert.Fail(t, "unexpected event", "%+v", ev)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func assertClosed(t *testing.T, events <-chan client.RecordEvent) {
+	t.Helper()
+	for {
+		select {
+		case _, ok := <-events:
+			if !ok {
+				return
+			}
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "not closed")
+		}
+	}
+}
+
+type failingCheckpointStore struct {
+	err error
+}
+
+func (s failingCheckpointStore) Load(ctx context.Context, key string) (int64, bool, error) {
+	return 0, false, s.err
+}
+
+func (s failingCheckpointStore) Save(ctx context.Context, key string, serial int64) error {
+	return s.err
+}
+
+func TestRecordApiService_Follow(t *testing.T) {
+	node := clienttest.NewNode(nil)
+	defer node.Close()
+	c := node.NewClient()
+	chain := node.CreateChain("chain")
+	node.AddRecord(chain, 1, []byte{0xF8, 0x34, 0x00})
+	node.AddRecord(chain, 1, []byte{0xF8, 0x34, 0x00})
+	store := client.NewMemoryCheckpointStore()
+	opts := &client.RecordApiFollowOpts{
+		PageSize:        1,
+		MinPollInterval: time.Millisecond,
+		MaxPollInterval: 5 * time.Millisecond,
+		Checkpoints:     store,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := c.RecordApi.Follow(ctx, chain, 1, opts)
+	ev := receiveEvent(t, events)
+	require.Nil(t, ev.Err)
+	assert.Equal(t, int64(1), ev.Serial)
+	require.NotNil(t, ev.Record)
+	assert.Equal(t, int64(1), ev.Record.Serial)
+	assert.Nil(t, ev.JsonRecord)
+	// The next record waits for the commit
+	assertNoEvent(t, events)
+	require.Nil(t, ev.Commit())
+	ev = receiveEvent(t, events)
+	assert.Equal(t, int64(2), ev.Serial)
+	require.Nil(t, ev.Commit())
+
+	node.AddRecord(chain, 1, []byte{0xF8, 0x34, 0x00})
+	ev = receiveEvent(t, events)
+	assert.Equal(t, int64(3), ev.Serial)
+	require.Nil(t, ev.Commit())
+	assertNoEvent(t, events)
+	cancel()
+	assertClosed(t, events)
+	serial, ok, err := store.Load(ctx, chain)
+	require.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), serial)
+
+	// Resumes from the checkpoint, ignoring fromSerial
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	events = c.RecordApi.Follow(ctx, chain, 0, opts)
+	node.AddRecord(chain, 1, []byte{0xF8, 0x34, 0x00})
+	ev = receiveEvent(t, events)
+	assert.Equal(t, int64(4), ev.Serial)
+	// Received but not committed: delivered again after a restart
+	cancel()
+	assertClosed(t, events)
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	events = c.RecordApi.Follow(ctx, chain, 0, opts)
+	ev = receiveEvent(t, events)
+	assert.Equal(t, int64(4), ev.Serial)
+}
+
+func TestRecordApiService_Follow_AsJson(t *testing.T) {
+	node := clienttest.NewNode(nil)
+	defer node.Close()
+	c := node.NewClient()
+	chain := node.CreateChain("chain")
+	node.AddJSONRecord(chain, 1, 300, map[string]any{"a": 1})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := c.RecordApi.Follow(ctx, chain, 0, &client.RecordApiFollowOpts{
+		AsJson:          true,
+		MinPollInterval: time.Millisecond,
+	})
+	// No checkpoint store, no commit required
+	for i := int64(0); i < 2; i++ {
+		ev := receiveEvent(t, events)
+		require.Nil(t, ev.Err)
+		assert.Equal(t, i, ev.Serial)
+		assert.Nil(t, ev.Record)
+		require.NotNil(t, ev.JsonRecord)
+		assert.Equal(t, i, ev.JsonRecord.Serial)
+		assert.Nil(t, ev.Commit())
+	}
+	assert.Equal(t, 0, node.Calls("Records_List"))
+	cancel()
+	assertClosed(t, events)
+}
+
+func TestRecordApiService_Follow_Errors(t *testing.T) {
+	node := clienttest.NewNode(nil)
+	defer node.Close()
+	c := node.NewClient()
+	chain := node.CreateChain("chain")
+	opts := &client.RecordApiFollowOpts{
+		MinPollInterval: time.Millisecond,
+		MaxPollInterval: 2 * time.Millisecond,
+	}
+
+	// Errors are delivered and the chain is polled again
+	node.FailNext("Chain_Details", http.StatusInternalServerError, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := c.RecordApi.Follow(ctx, chain, 0, opts)
+	ev := receiveEvent(t, events)
+	assert.ErrorIs(t, ev.Err, client.ErrServerError)
+	assert.Nil(t, ev.Commit())
+	ev = receiveEvent(t, events)
+	require.Nil(t, ev.Err)
+	assert.Equal(t, int64(0), ev.Serial)
+	cancel()
+	assertClosed(t, events)
+
+	// Unable to load the checkpoint
+	fail := errors.New("fail")
+	opts.Checkpoints = failingCheckpointStore{fail}
+	events = c.RecordApi.Follow(context.Background(), chain, 0, opts)
+	ev = receiveEvent(t, events)
+	assert.Same(t, fail, ev.Err)
+	assertClosed(t, events)
+}
@@ -0,0 +1,180 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+)
+
+/*
+Interface of the chain related API calls implemented by ChainApiService.
+*/
+type ChainAPI interface {
+	ChainActiveAppsAdd(ctx context.Context, chain string, apps []int64) ([]int64, *http.Response, error)
+	ChainActiveAppsList(ctx context.Context, chain string) ([]int64, *http.Response, error)
+	ChainCreate(ctx context.Context, creationParams *models.ChainCreatedModel) (models.ChainCreatedModel, *http.Response, error)
+	ChainDetails(ctx context.Context, chain string) (models.ChainSummaryModel, *http.Response, error)
+	ChainInterlockingAdd(ctx context.Context, chain string, params *models.ForceInterlockModel) (models.InterlockingRecordModel, *http.Response, error)
+	ChainInterlockingsList(ctx context.Context, chain string, params *ChainApiChainInterlockingsListOpts) (models.InterlockingRecordModelPageOf, *http.Response, error)
+	ChainPermittedKeysAdd(ctx context.Context, chain string, keys []models.KeyPermitModel) ([]models.KeyDetailsModel, *http.Response, error)
+	ChainPermittedKeysList(ctx context.Context, chain string) ([]models.KeyDetailsModel, *http.Response, error)
+	ChainsList(ctx context.Context) ([]models.ChainIdModel, *http.Response, error)
+}
+
+/*
+Interface of the documents related API calls implemented by
+DocumentsApiService.
+*/
+type DocumentsAPI interface {
+	DocumentsAddDocument(ctx context.Context, transactionId string, document *DocumentsApiDocumentsAddDocumentParams) (models.DocumentsTransactionModel, *http.Response, error)
+	DocumentsBeginTransaction(ctx context.Context, body *models.DocumentsBeginTransactionModel) (models.DocumentsTransactionModel, *http.Response, error)
+	DocumentsCommitTransaction(ctx context.Context, transactionId string) (string, *http.Response, error)
+	DocumentsGetAllDocuments(ctx context.Context, locator string) (*http.Response, error)
+	DocumentsGetConfig(ctx context.Context) (models.DocumentUploadConfiguration, *http.Response, error)
+	DocumentsGetMetadata(ctx context.Context, locator string) (models.DocumentsMetadataModel, *http.Response, error)
+	DocumentsGetSingleDocument(ctx context.Context, locator string, index int32) (*http.Response, error)
+	DocumentsGetTransactionStatus(ctx context.Context, transactionId string) (models.DocumentsTransactionModel, *http.Response, error)
+}
+
+/*
+Interface of the JSON documents related API calls implemented by
+JsonDocumentApiService.
+*/
+type JsonDocumentAPI interface {
+	JsonDocumentsAdd(ctx context.Context, chain string, jsonDoc models.Object) (models.JsonDocumentModel, *http.Response, error)
+	JsonDocumentsAddWithChainKeys(ctx context.Context, chain string, xPubKeyChains []string, jsonDoc models.Object) (models.JsonDocumentModel, *http.Response, error)
+	JsonDocumentsAddWithIndirectKeys(ctx context.Context, chain string, xPubKeyReferences []string, jsonDoc models.Object) (models.JsonDocumentModel, *http.Response, error)
+	JsonDocumentsAddWithKey(ctx context.Context, chain string, xPubKey string, xPubKeyId string, jsonDoc models.Object) (models.JsonDocumentModel, *http.Response, error)
+	JsonDocumentsAllowReaders(ctx context.Context, chain string, allowedReaders *models.AllowedReadersModel) (string, *http.Response, error)
+	JsonDocumentsGet(ctx context.Context, chain string, serial int64) (models.JsonDocumentModel, *http.Response, error)
+}
+
+/*
+Interface of the node related API calls implemented by NodeApiService.
+*/
+type NodeAPI interface {
+	ApiVersion(ctx context.Context) (string, *http.Response, error)
+	AppsList(ctx context.Context) (models.AppsModel, *http.Response, error)
+	InterlockingsList(ctx context.Context, targetChain string, optionalParams *NodeApiInterlockingsListOpts) (models.InterlockingRecordModelPageOf, *http.Response, error)
+	MirrorAdd(ctx context.Context, chains []string) ([]models.ChainIdModel, *http.Response, error)
+	MirrorsList(ctx context.Context) ([]models.ChainIdModel, *http.Response, error)
+	NodeDetails(ctx context.Context) (models.NodeDetailsModel, *http.Response, error)
+	PeersList(ctx context.Context) ([]models.PeerModel, *http.Response, error)
+}
+
+/*
+Interface of the record related API calls implemented by RecordApiService.
+*/
+type RecordAPI interface {
+	RecordAdd(ctx context.Context, chain string, record *models.NewRecordModel) (models.RecordModel, *http.Response, error)
+	RecordAddAsJson(ctx context.Context, chain string, options *RecordApiRecordAddAsJsonOpts, jsonPayload interface{}) (models.RecordModel, *http.Response, error)
+	RecordGet(ctx context.Context, chain string, serial int64) (models.RecordModel, *http.Response, error)
+	RecordGetAsJson(ctx context.Context, chain string, serial int64) (models.RecordModelAsJson, *http.Response, error)
+	GetByReference(ctx context.Context, ref models.UniversalRecordReference) (models.RecordModel, *http.Response, error)
+	GetAsJsonByReference(ctx context.Context, ref models.UniversalRecordReference) (models.RecordModelAsJson, *http.Response, error)
+	RecordsList(ctx context.Context, chain string, options *RecordApiRecordsListOpts) (models.RecordModelPageOf, *http.Response, error)
+	RecordsListAsJson(ctx context.Context, chain string, options *RecordApiRecordsListAsJsonOpts) (models.RecordModelAsJsonPageOf, *http.Response, error)
+	RecordsQuery(ctx context.Context, chain string, options *RecordApiRecordsQueryOpts) (models.RecordModelPageOf, *http.Response, error)
+	RecordsQueryAsJson(ctx context.Context, chain string, options *RecordApiRecordsQueryAsJsonOpts) (models.RecordModelAsJsonPageOf, *http.Response, error)
+	Follow(ctx context.Context, chain string, fromSerial int64, options *RecordApiFollowOpts) <-chan RecordEvent
+	ExportChain(ctx context.Context, chain string, w io.Writer, options *RecordApiExportOpts) (int64, error)
+	ExportChainToFile(ctx context.Context, chain string, file string, options *RecordApiExportOpts) (int64, error)
+}
+
+/*
+Interface of the opaque records related API calls implemented by
+OpaqueService.
+*/
+type OpaqueAPI interface {
+	Create(ctx context.Context, chain string, appId int64, payloadType int64, payload io.Reader, lastChangedRecordSerial int64) (models.OpaqueRecordModel, *http.Response, error)
+	Get(ctx context.Context, chain string, serial int64) ([]byte, int64, int64, *http.Response, error)
+	QueryJson(ctx context.Context, chain string, appId int64, payloadTypeIds []int64, howMany int64, lastToFirst bool, page int, pageSize int) (models.PageOfOpaqueRecordsModel, *http.Response, error)
+}
+
+/*
+Interface of the whole client implemented by APIClient. Code that depends on
+this interface instead of *APIClient can be tested with the mocks provided by
+the package clienttest, without a network connection.
+*/
+type Client interface {
+	Chain() ChainAPI
+	Documents() DocumentsAPI
+	JsonDocument() JsonDocumentAPI
+	Node() NodeAPI
+	Record() RecordAPI
+	Opaque() OpaqueAPI
+	Do(ctx context.Context, method, path string, query url.Values, body any, out any) (*http.Response, error)
+}
+
+var (
+	_ ChainAPI        = (*ChainApiService)(nil)
+	_ DocumentsAPI    = (*DocumentsApiService)(nil)
+	_ JsonDocumentAPI = (*JsonDocumentApiService)(nil)
+	_ NodeAPI         = (*NodeApiService)(nil)
+	_ RecordAPI       = (*RecordApiService)(nil)
+	_ OpaqueAPI       = (*OpaqueService)(nil)
+	_ Client          = (*APIClient)(nil)
+)
+
+// Returns the chain API. It is the same as ChainApi.
+func (c *APIClient) Chain() ChainAPI {
+	return c.ChainApi
+}
+
+// Returns the documents API. It is the same as DocumentsApi.
+func (c *APIClient) Documents() DocumentsAPI {
+	return c.DocumentsApi
+}
+
+// Returns the JSON documents API. It is the same as JsonDocumentApi.
+func (c *APIClient) JsonDocument() JsonDocumentAPI {
+	return c.JsonDocumentApi
+}
+
+// Returns the node API. It is the same as NodeApi.
+func (c *APIClient) Node() NodeAPI {
+	return c.NodeApi
+}
+
+// Returns the record API. It is the same as RecordApi.
+func (c *APIClient) Record() RecordAPI {
+	return c.RecordApi
+}
+
+// Returns the opaque records API. It is the same as OpaqueApi.
+func (c *APIClient) Opaque() OpaqueAPI {
+	return c.OpaqueApi
+}
@@ -0,0 +1,515 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package interlockql
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+/*
+The query is not valid. All errors returned by this package wrap this error.
+*/
+var ErrInvalidQuery = errors.New("invalid InterlockQL query")
+
+/*
+An operand of a comparison. It is either a FieldRef or a Literal.
+*/
+type Operand interface {
+	// Returns the operand as it appears in the query.
+	String() string
+	validate() error
+}
+
+/*
+A boolean expression. It is either a *Comparison, a *Logical or a *Negation.
+*/
+type Expr interface {
+	// Returns the expression as it appears in the query.
+	String() string
+	validate() error
+}
+
+/*
+Reference to a field, e.g. ApplicationId or Payload.Name.
+*/
+type FieldRef struct {
+	Path []string
+}
+
+/*
+Creates a reference to a field. Each element of the path may also contain
+dots, thus Field("Payload", "Name") and Field("Payload.Name") are the same.
+*/
+func Field(path ...string) FieldRef {
+	var ret FieldRef
+	for _, p := range path {
+		ret.Path = append(ret.Path, strings.Split(p, ".")...)
+	}
+	return ret
+}
+
+/*
+Creates a reference to a field of the payload.
+*/
+func Payload(path ...string) FieldRef {
+	return Field(append([]string{"Payload"}, path...)...)
+}
+
+// Implements Operand.
+func (f FieldRef) String() string {
+	return strings.Join(f.Path, ".")
+}
+
+// Keywords of the language.
+var keywords = map[string]bool{
+	"USE": true, "WHERE": true, "AND": true, "OR": true, "NOT": true,
+	"TRUE": true, "FALSE": true, "NULL": true,
+}
+
+// Returns true if s is a keyword, ignoring the case.
+func isKeyword(s string) bool {
+	return keywords[strings.ToUpper(s)]
+}
+
+func isNameStart(c rune) bool {
+	return c == '_' || c < utf8.RuneSelf && unicode.IsLetter(c)
+}
+
+func isDigit(c rune) bool {
+	return c >= '0' && c <= '9'
+}
+
+// Returns true if s is a valid identifier, which may still be a keyword.
+func isIdentifier(s string) bool {
+	for i, c := range s {
+		if !isNameStart(c) && (i == 0 || !isDigit(c)) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// Returns true if s is a valid name that is not a keyword.
+func isName(s string) bool {
+	return isIdentifier(s) && !isKeyword(s)
+}
+
+func (f FieldRef) validate() error {
+	if len(f.Path) == 0 {
+		return fmt.Errorf("%w: empty field", ErrInvalidQuery)
+	}
+	// Only the first name may be taken for a keyword, the ones after a dot
+	// are always names, e.g. Payload.Use.
+	for i, p := range f.Path {
+		if i == 0 && !isName(p) || !isIdentifier(p) {
+			return fmt.Errorf("%w: invalid field %q", ErrInvalidQuery, f.String())
+		}
+	}
+	return nil
+}
+
+// Creates a comparison between the field and the operand.
+func (f FieldRef) compare(op Op, o Operand) Expr {
+	return &Comparison{Left: f, Op: op, Right: o}
+}
+
+// Creates the comparison f == o.
+func (f FieldRef) Eq(o Operand) Expr { return f.compare(Eq, o) }
+
+// Creates the comparison f != o.
+func (f FieldRef) Ne(o Operand) Expr { return f.compare(Ne, o) }
+
+// Creates the comparison f < o.
+func (f FieldRef) Lt(o Operand) Expr { return f.compare(Lt, o) }
+
+// Creates the comparison f <= o.
+func (f FieldRef) Le(o Operand) Expr { return f.compare(Le, o) }
+
+// Creates the comparison f > o.
+func (f FieldRef) Gt(o Operand) Expr { return f.compare(Gt, o) }
+
+// Creates the comparison f >= o.
+func (f FieldRef) Ge(o Operand) Expr { return f.compare(Ge, o) }
+
+/*
+A literal value. Use the functions Text(), Int(), Uint(), Float(), Bool(),
+Time() and Null() to create it.
+*/
+type Literal struct {
+	text string
+	err  error
+}
+
+/*
+Creates a string literal. It is enclosed in double quotes, where '"' and '\\'
+are escaped by a backslash and the control and non-printable characters are
+written as \uXXXX. Strings that are not valid UTF-8 are invalid.
+*/
+func Text(s string) Literal {
+	if !utf8.ValidString(s) {
+		return Literal{err: fmt.Errorf("%w: invalid UTF-8 string %q", ErrInvalidQuery, s)}
+	}
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case unicode.IsPrint(r):
+			b.WriteRune(r)
+		case r > 0xFFFF:
+			r1, r2 := utf16.EncodeRune(r)
+			fmt.Fprintf(&b, "\\u%04x\\u%04x", r1, r2)
+		default:
+			fmt.Fprintf(&b, "\\u%04x", r)
+		}
+	}
+	b.WriteByte('"')
+	return Literal{text: b.String()}
+}
+
+/*
+Creates an integer literal.
+*/
+func Int(n int64) Literal {
+	return Literal{text: strconv.FormatInt(n, 10)}
+}
+
+/*
+Creates an unsigned integer literal.
+*/
+func Uint(n uint64) Literal {
+	return Literal{text: strconv.FormatUint(n, 10)}
+}
+
+/*
+Creates a floating point literal. NaN and infinities are invalid.
+*/
+func Float(f float64) Literal {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Literal{err: fmt.Errorf("%w: invalid number %v", ErrInvalidQuery, f)}
+	}
+	return Literal{text: strconv.FormatFloat(f, 'g', -1, 64)}
+}
+
+/*
+Creates a boolean literal.
+*/
+func Bool(b bool) Literal {
+	return Literal{text: strconv.FormatBool(b)}
+}
+
+/*
+Creates a string literal with the time in RFC 3339 format.
+*/
+func Time(t time.Time) Literal {
+	return Text(t.Format(time.RFC3339Nano))
+}
+
+/*
+Creates the null literal.
+*/
+func Null() Literal {
+	return Literal{text: "null"}
+}
+
+// Implements Operand.
+func (l Literal) String() string {
+	return l.text
+}
+
+func (l Literal) validate() error {
+	if l.err != nil {
+		return l.err
+	}
+	if l.text == "" {
+		return fmt.Errorf("%w: empty literal", ErrInvalidQuery)
+	}
+	return nil
+}
+
+/*
+Comparison operator.
+*/
+type Op string
+
+const (
+	Eq Op = "=="
+	Ne Op = "!="
+	Lt Op = "<"
+	Le Op = "<="
+	Gt Op = ">"
+	Ge Op = ">="
+)
+
+/*
+Comparison between two operands.
+*/
+type Comparison struct {
+	Left  Operand
+	Op    Op
+	Right Operand
+}
+
+// Implements Expr.
+func (c *Comparison) String() string {
+	return fmt.Sprintf("%s %s %s", operandString(c.Left), c.Op, operandString(c.Right))
+}
+
+// Returns the string of an operand that may be nil.
+func operandString(o Operand) string {
+	if o == nil {
+		return ""
+	}
+	return o.String()
+}
+
+func (c *Comparison) validate() error {
+	if c.Left == nil || c.Right == nil {
+		return fmt.Errorf("%w: missing operand in %q", ErrInvalidQuery, c.String())
+	}
+	switch c.Op {
+	case Eq, Ne, Lt, Le, Gt, Ge:
+	default:
+		return fmt.Errorf("%w: invalid operator %q", ErrInvalidQuery, c.Op)
+	}
+	if err := c.Left.validate(); err != nil {
+		return err
+	}
+	return c.Right.validate()
+}
+
+/*
+Logical operator.
+*/
+type LogicalOp string
+
+const (
+	AndOp LogicalOp = "AND"
+	OrOp  LogicalOp = "OR"
+)
+
+/*
+Combination of expressions with AND or OR.
+*/
+type Logical struct {
+	Op       LogicalOp
+	Operands []Expr
+}
+
+/*
+Combines the expressions with AND. A single expression is returned as is.
+*/
+func And(e ...Expr) Expr {
+	return logical(AndOp, e)
+}
+
+/*
+Combines the expressions with OR. A single expression is returned as is.
+*/
+func Or(e ...Expr) Expr {
+	return logical(OrOp, e)
+}
+
+func logical(op LogicalOp, e []Expr) Expr {
+	if len(e) == 1 {
+		return e[0]
+	}
+	return &Logical{Op: op, Operands: e}
+}
+
+// Implements Expr.
+func (l *Logical) String() string {
+	s := make([]string, len(l.Operands))
+	for i, e := range l.Operands {
+		if e == nil {
+			continue
+		}
+		s[i] = e.String()
+		if c, ok := e.(*Logical); ok && c.Op != l.Op && len(c.Operands) > 1 {
+			s[i] = "(" + s[i] + ")"
+		}
+	}
+	return strings.Join(s, " "+string(l.Op)+" ")
+}
+
+func (l *Logical) validate() error {
+	if l.Op != AndOp && l.Op != OrOp {
+		return fmt.Errorf("%w: invalid operator %q", ErrInvalidQuery, l.Op)
+	}
+	if len(l.Operands) == 0 {
+		return fmt.Errorf("%w: %s without operands", ErrInvalidQuery, l.Op)
+	}
+	for _, e := range l.Operands {
+		if e == nil {
+			return fmt.Errorf("%w: nil expression in %s", ErrInvalidQuery, l.Op)
+		}
+		if err := e.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+Negation of an expression.
+*/
+type Negation struct {
+	Operand Expr
+}
+
+/*
+Negates the expression.
+*/
+func Not(e Expr) Expr {
+	return &Negation{Operand: e}
+}
+
+// Implements Expr.
+func (n *Negation) String() string {
+	if n.Operand == nil {
+		return "NOT ()"
+	}
+	return "NOT (" + n.Operand.String() + ")"
+}
+
+func (n *Negation) validate() error {
+	if n.Operand == nil {
+		return fmt.Errorf("%w: nil expression in NOT", ErrInvalidQuery)
+	}
+	return n.Operand.validate()
+}
+
+/*
+Selects the records of the given applications.
+*/
+func App(ids ...int64) Expr {
+	return anyOf(Field("ApplicationId"), ids)
+}
+
+/*
+Selects the records whose payloads have one of the given tag ids.
+*/
+func PayloadTag(ids ...int64) Expr {
+	return anyOf(Field("PayloadTagId"), ids)
+}
+
+// Returns an expression that matches any of the values.
+func anyOf(f FieldRef, ids []int64) Expr {
+	e := make([]Expr, len(ids))
+	for i, id := range ids {
+		e[i] = f.Eq(Int(id))
+	}
+	return Or(e...)
+}
+
+/*
+A complete query. Both parts are optional.
+*/
+type Query struct {
+	// Name used by the USE clause.
+	Use string
+	// Condition of the WHERE clause.
+	Where Expr
+}
+
+/*
+Creates a query with the given condition.
+*/
+func Where(e Expr) Query {
+	return Query{Where: e}
+}
+
+/*
+Creates a query with the given USE clause.
+*/
+func Use(name string) Query {
+	return Query{Use: name}
+}
+
+/*
+Returns a copy of the query with the given condition.
+*/
+func (q Query) WithWhere(e Expr) Query {
+	q.Where = e
+	return q
+}
+
+/*
+Returns the query as a string. Use Build() to make sure it is valid.
+*/
+func (q Query) String() string {
+	var parts []string
+	if q.Use != "" {
+		parts = append(parts, "USE "+q.Use)
+	}
+	if q.Where != nil {
+		if q.Use != "" {
+			parts = append(parts, "WHERE")
+		}
+		parts = append(parts, q.Where.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+/*
+Checks if the query can be written with the names, operators and literals it
+holds. It returns an error that wraps ErrInvalidQuery otherwise. It does not
+check if the node accepts the query, e.g. if the fields exist.
+*/
+func (q Query) Validate() error {
+	if q.Use != "" && !isName(q.Use) {
+		return fmt.Errorf("%w: invalid name %q in USE", ErrInvalidQuery, q.Use)
+	}
+	if q.Where != nil {
+		return q.Where.validate()
+	}
+	return nil
+}
+
+/*
+Validates the query and returns it as a string.
+*/
+func (q Query) Build() (string, error) {
+	if err := q.Validate(); err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
@@ -0,0 +1,102 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package interlockql
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilder(t *testing.T) {
+	q := Where(And(
+		App(8),
+		PayloadTag(1000, 1001),
+		Payload("Name").Eq(Text(`say "hi"`)),
+		Or(Payload("Amount").Ge(Int(10)), Not(Field("Payload.Active").Eq(Bool(true)))),
+		Field("Serial").Lt(Uint(100)),
+		Payload("Ratio").Ne(Float(0.5)),
+		Payload("Deleted").Eq(Null()),
+	))
+	s, err := q.Build()
+	require.NoError(t, err)
+	assert.Equal(t, `ApplicationId == 8 AND (PayloadTagId == 1000 OR PayloadTagId == 1001) AND `+
+		`Payload.Name == "say \"hi\"" AND (Payload.Amount >= 10 OR NOT (Payload.Active == true)) AND `+
+		`Serial < 100 AND Payload.Ratio != 0.5 AND Payload.Deleted == null`, s)
+
+	// Only the first name of a field may not be a keyword.
+	s, err = Where(And(Payload("Use").Eq(Text("x")), Payload("Null", "not").Ne(Null()))).Build()
+	require.NoError(t, err)
+	assert.Equal(t, `Payload.Use == "x" AND Payload.Null.not != null`, s)
+
+	s, err = Use("default").WithWhere(Field("CreatedAt").Gt(Time(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)))).Build()
+	require.NoError(t, err)
+	assert.Equal(t, `USE default WHERE CreatedAt > "2024-01-02T03:04:05Z"`, s)
+
+	s, err = Where(Field("a").Eq(Text("\\é\t\u200b\U0001F600"))).Build()
+	require.NoError(t, err)
+	assert.Equal(t, `a == "\\é\u0009\u200b😀"`, s)
+
+	s, err = Use("default").Build()
+	require.NoError(t, err)
+	assert.Equal(t, "USE default", s)
+
+	s, err = Query{}.Build()
+	require.NoError(t, err)
+	assert.Equal(t, "", s)
+}
+
+func TestBuilderErrors(t *testing.T) {
+	for _, q := range []Query{
+		Use("not valid"),
+		Use("where"),
+		Where(Field().Eq(Int(1))),
+		Where(Field("a b").Eq(Int(1))),
+		Where(Field("Payload..Name").Eq(Int(1))),
+		Where(Field("1st").Eq(Int(1))),
+		Where(Field("and").Eq(Int(1))),
+		Where(Field("Null", "Name").Eq(Int(1))),
+		Where(Field("a").Eq(Float(math.NaN()))),
+		Where(Field("a").Eq(Text("\xff"))),
+		Where(Field("a").Eq(Literal{})),
+		Where(Field("a").Eq(nil)),
+		Where(And()),
+		Where(Or(Field("a").Eq(Int(1)), nil)),
+		Where(Not(nil)),
+		Where(&Comparison{Left: Field("a"), Op: "=", Right: Int(1)}),
+	} {
+		_, err := q.Build()
+		assert.ErrorIs(t, err, ErrInvalidQuery, q.String())
+	}
+}
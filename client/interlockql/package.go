@@ -0,0 +1,74 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+This package builds InterlockQL queries, used by RecordApi.RecordsQuery() and
+RecordApi.RecordsQueryAsJson().
+
+Queries are built from typed fields, literals and operators, thus values are
+always properly quoted:
+
+	q := interlockql.Where(interlockql.And(
+		interlockql.App(8),
+		interlockql.PayloadTag(1000),
+		interlockql.Payload("Name").Eq(interlockql.Text(name)),
+		interlockql.Payload("Amount").Ge(interlockql.Int(10)),
+	))
+	s, err := q.Build()
+	if err != nil {
+		...
+	}
+	options.QueryAsInterlockQL = optional.NewString(s)
+
+The queries are written in the following form, where WHERE is written only
+after a USE clause:
+
+	[ USE name ] [ WHERE ] expression
+
+Expressions are comparisons between fields and literals, with the operators
+==, !=, <, <=, > and >=, combined by AND, OR and NOT. Nested expressions are
+always enclosed in parentheses. The literals true, false and null and the
+keywords USE, WHERE, AND, OR and NOT are reserved. Strings are enclosed in double quotes, where
+'"' and '\\' are escaped by a backslash and the control characters are written
+as \uXXXX.
+
+Names start with a letter or '_' followed by letters, digits or '_'. The first
+name of a field may not be a keyword, but the names after a dot may, thus
+Payload.Use and Payload.Null can be referred.
+
+The fields of the record are referred by their names, e.g. ApplicationId and
+PayloadTagId, while the fields of the payload are prefixed by Payload.
+
+The InterlockQL grammar is not published by the node, thus this package does
+not parse nor validate query strings. Build() only checks that the query can
+be written, the node may still reject it, e.g. if it refers to fields that do
+not exist.
+*/
+package interlockql
@@ -89,7 +89,7 @@ func (a *JsonDocumentApiService) JsonDocumentsAdd(ctx context.Context, chain str
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("JsonDocuments_Add", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -109,32 +109,7 @@ func (a *JsonDocumentApiService) JsonDocumentsAdd(ctx context.Context, chain str
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v models.JsonDocumentModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("JsonDocuments_Add", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -184,7 +159,7 @@ func (a *JsonDocumentApiService) JsonDocumentsAddWithChainKeys(ctx context.Conte
 		r.Header.Add("X-PubKeyChains", v)
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("JsonDocuments_Add_WithChainKeys", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -204,32 +179,7 @@ func (a *JsonDocumentApiService) JsonDocumentsAddWithChainKeys(ctx context.Conte
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v models.JsonDocumentModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("JsonDocuments_Add_WithChainKeys", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -279,7 +229,7 @@ func (a *JsonDocumentApiService) JsonDocumentsAddWithIndirectKeys(ctx context.Co
 		r.Header.Add("X-PubKeyReferences", v)
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("JsonDocuments_Add_WithIndirectKeys", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -299,32 +249,7 @@ func (a *JsonDocumentApiService) JsonDocumentsAddWithIndirectKeys(ctx context.Co
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v models.JsonDocumentModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("JsonDocuments_Add_WithIndirectKeys", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -372,7 +297,7 @@ func (a *JsonDocumentApiService) JsonDocumentsAddWithKey(ctx context.Context, ch
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("JsonDocuments_Add_WithKey", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -392,33 +317,7 @@ func (a *JsonDocumentApiService) JsonDocumentsAddWithKey(ctx context.Context, ch
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v models.JsonDocumentModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			fmt.Println(string(localVarBody))
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("JsonDocuments_Add_WithKey", localVarHttpResponse, localVarBody)
 	}
 
 	return localVarReturnValue, localVarHttpResponse, nil
@@ -468,7 +367,7 @@ func (a *JsonDocumentApiService) JsonDocumentsAllowReaders(ctx context.Context,
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("JsonDocuments_AllowReaders", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -488,32 +387,7 @@ func (a *JsonDocumentApiService) JsonDocumentsAllowReaders(ctx context.Context,
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v models.RecordReference
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("JsonDocuments_AllowReaders", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -561,7 +435,7 @@ func (a *JsonDocumentApiService) JsonDocumentsGet(ctx context.Context, chain str
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callCachedAPI("JsonDocuments_Get", chain, serial, r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -581,32 +455,7 @@ func (a *JsonDocumentApiService) JsonDocumentsGet(ctx context.Context, chain str
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v models.JsonDocumentModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("JsonDocuments_Get", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
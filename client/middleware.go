@@ -0,0 +1,81 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"net/http"
+)
+
+/*
+Handler sends a single request to the server. The operation is the name of the
+API operation as defined by the node's OpenAPI specification, such as
+"Records_List" or "Record_Add".
+*/
+type Handler func(operation string, request *http.Request) (*http.Response, error)
+
+/*
+Middleware wraps a Handler in order to inspect or modify the requests and the
+responses. It may also short-circuit the call by returning its own response or
+error without calling next.
+*/
+type Middleware func(next Handler) Handler
+
+/*
+Adds the given middlewares to the chain of this client. They are executed in the
+order they were added, thus the first one will be the outermost handler.
+
+The middlewares are called once for each attempt, thus a request that is
+retried according to the RetryPolicy will pass through the chain more than
+once. Responses served by the ResponseCache do not pass through the chain, thus
+the middlewares only see the calls that reach the node. This method is not safe
+to be called concurrently with API calls.
+*/
+func (c *APIClient) Use(middlewares ...Middleware) {
+	c.middlewares = append(c.middlewares, middlewares...)
+}
+
+// Sends the request through the middleware chain.
+func (c *APIClient) send(operation string, request *http.Request) (*http.Response, error) {
+	var h Handler = func(_ string, request *http.Request) (*http.Response, error) {
+		if c.cacheErr != nil {
+			return nil, c.cacheErr
+		} else if c.routerErr != nil {
+			return nil, c.routerErr
+		} else if c.router != nil {
+			return c.router.do(c.cfg.HTTPClient, request)
+		}
+		return c.cfg.HTTPClient.Do(request)
+	}
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		h = c.middlewares[i](h)
+	}
+	return h(operation, request)
+}
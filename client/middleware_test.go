@@ -0,0 +1,121 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIClient_Use(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Echo", r.Header.Get("X-Correlation-Id"))
+		w.Write([]byte(`{"items":[],"page":0}`))
+	}))
+	defer server.Close()
+	cfg := NewConfiguration()
+	cfg.BasePath = server.URL
+	cfg.HTTPClient = server.Client()
+	c := NewAPIClient(cfg)
+
+	var log []string
+	c.Use(func(next Handler) Handler {
+		return func(operation string, request *http.Request) (*http.Response, error) {
+			log = append(log, "1:"+operation)
+			request.Header.Set("X-Correlation-Id", "abc")
+			resp, err := next(operation, request)
+			log = append(log, fmt.Sprintf("1:%d", resp.StatusCode))
+			return resp, err
+		}
+	}, func(next Handler) Handler {
+		return func(operation string, request *http.Request) (*http.Response, error) {
+			log = append(log, "2:"+request.URL.Path)
+			resp, err := next(operation, request)
+			log = append(log, "2:"+resp.Header.Get("X-Echo"))
+			return resp, err
+		}
+	})
+
+	_, resp, err := c.RecordApi.RecordsList(context.Background(), "chain", nil)
+	require.Nil(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, []string{
+		"1:Records_List",
+		"2:/records@chain",
+		"2:abc",
+		"1:200",
+	}, log)
+}
+
+func TestAPIClient_Use_FaultInjection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`"7.2.0"`))
+	}))
+	defer server.Close()
+	cfg := NewConfiguration()
+	cfg.BasePath = server.URL
+	cfg.HTTPClient = server.Client()
+	cfg.RetryPolicy.InitialBackoff = time.Millisecond
+	c := NewAPIClient(cfg)
+
+	count := 0
+	c.Use(func(next Handler) Handler {
+		return func(operation string, request *http.Request) (*http.Response, error) {
+			count++
+			if count == 1 {
+				return &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+					Status:     "503 Service Unavailable",
+					Header:     make(http.Header),
+					Body:       io.NopCloser(strings.NewReader("")),
+					Request:    request,
+				}, nil
+			}
+			return next(operation, request)
+		}
+	})
+
+	v, _, err := c.NodeApi.ApiVersion(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, "7.2.0", v)
+	assert.Equal(t, 2, count)
+}
@@ -37,3 +37,22 @@ type AppsModel struct {
 	// Currently valid apps for this network
 	ValidApps []IInterlockAppTraits `json:"validApps,omitempty"`
 }
+
+/*
+Returns the data model of the payload with the given tag id registered by the
+given application. It returns nil if the data model is not found.
+*/
+func (m *AppsModel) FindDataModel(appId int64, payloadTagId int64) *DataModel {
+	for i := range m.ValidApps {
+		app := &m.ValidApps[i]
+		if app.Id != appId {
+			continue
+		}
+		for j := range app.DataModels {
+			if app.DataModels[j].PayloadTagId == payloadTagId {
+				return &app.DataModels[j]
+			}
+		}
+	}
+	return nil
+}
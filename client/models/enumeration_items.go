@@ -30,6 +30,136 @@
 
 package models
 
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+Definition of a value of an enumeration.
+*/
+type EnumerationItem struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+/*
+Enumeration of a DataField. The node sends it as an object whose keys are the
+values of the enumeration, thus it has custom JSON encoding.
+*/
 type EnumerationItems struct {
 	IsInvalid bool `json:"isInvalid,omitempty"`
+	// Items of the enumeration indexed by their values.
+	Items map[uint64]EnumerationItem `json:"-"`
+}
+
+// Implements json.Unmarshaler.
+func (e *EnumerationItems) UnmarshalJSON(b []byte) error {
+	var entries map[string]json.RawMessage
+	if err := json.Unmarshal(b, &entries); err != nil {
+		return err
+	}
+	*e = EnumerationItems{}
+	for k, v := range entries {
+		if k == "isInvalid" {
+			if err := json.Unmarshal(v, &e.IsInvalid); err != nil {
+				return err
+			}
+			continue
+		}
+		value, err := strconv.ParseUint(k, 10, 64)
+		if err != nil {
+			// Unknown property.
+			continue
+		}
+		var item EnumerationItem
+		if err := json.Unmarshal(v, &item); err != nil {
+			return err
+		}
+		if e.Items == nil {
+			e.Items = make(map[uint64]EnumerationItem)
+		}
+		e.Items[value] = item
+	}
+	return nil
+}
+
+// Implements json.Marshaler.
+func (e EnumerationItems) MarshalJSON() ([]byte, error) {
+	entries := make(map[string]any, len(e.Items)+1)
+	if e.IsInvalid {
+		entries["isInvalid"] = true
+	}
+	for k, v := range e.Items {
+		entries[strconv.FormatUint(k, 10)] = v
+	}
+	return json.Marshal(entries)
+}
+
+/*
+Returns the name of the value n. If flags is true, n is treated as a set of
+flags and the names of all flags in it are joined with "|". The values without
+a name are formatted as decimal numbers.
+*/
+func (e *EnumerationItems) Format(n uint64, flags bool) string {
+	if item, ok := e.Items[n]; ok {
+		return item.Name
+	}
+	if !flags || n == 0 {
+		return strconv.FormatUint(n, 10)
+	}
+	values := make([]uint64, 0, len(e.Items))
+	for k := range e.Items {
+		if k != 0 {
+			values = append(values, k)
+		}
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	var names []string
+	rest := n
+	for _, k := range values {
+		if n&k == k {
+			names = append(names, e.Items[k].Name)
+			rest &^= k
+		}
+	}
+	if rest != 0 {
+		names = append(names, strconv.FormatUint(rest, 10))
+	}
+	return strings.Join(names, "|")
+}
+
+/*
+Parses a value formatted by Format(). Decimal numbers are accepted in place of
+the names.
+*/
+func (e *EnumerationItems) Parse(s string, flags bool) (uint64, error) {
+	find := func(name string) (uint64, error) {
+		name = strings.TrimSpace(name)
+		for k, item := range e.Items {
+			if item.Name == name {
+				return k, nil
+			}
+		}
+		n, err := strconv.ParseUint(name, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("unknown enumeration value %q", name)
+		}
+		return n, nil
+	}
+	if !flags {
+		return find(s)
+	}
+	var ret uint64
+	for _, name := range strings.Split(s, "|") {
+		n, err := find(name)
+		if err != nil {
+			return 0, err
+		}
+		ret |= n
+	}
+	return ret, nil
 }
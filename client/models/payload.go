@@ -0,0 +1,690 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/interlockledger/go-iltags/tags"
+	"github.com/interlockledger/go-iltags/tags/impl"
+)
+
+/*
+The payload does not match its data model. The errors returned by the payload
+decoders wrap this error.
+*/
+var ErrPayloadMismatch = errors.New("payload does not match the data model")
+
+/*
+Error that tells which field of the payload does not match the data model.
+*/
+type PayloadFieldError struct {
+	// Path of the field, e.g. "Items[2].Name". It is empty for the payload itself.
+	Path string
+	// Description of the problem.
+	Message string
+}
+
+// Implements error.
+func (e *PayloadFieldError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("payload: %s", e.Message)
+	}
+	return fmt.Sprintf("payload field %s: %s", e.Path, e.Message)
+}
+
+// Allows errors.Is(err, ErrPayloadMismatch).
+func (e *PayloadFieldError) Unwrap() error {
+	return ErrPayloadMismatch
+}
+
+// Creates a new PayloadFieldError.
+func newPayloadFieldError(path string, format string, args ...any) error {
+	return &PayloadFieldError{Path: path, Message: fmt.Sprintf(format, args...)}
+}
+
+// Returns the path of a field inside its parent.
+func fieldPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+/*
+Factory used to decode payloads. Tags that are not standard are decoded as
+raw tags.
+*/
+var payloadTagFactory = impl.NewStandardTagFactory(false)
+
+/*
+Decodes the payload into an ILTag tree. Standard tags are decoded into the
+implementations of the package github.com/interlockledger/go-iltags/tags/impl
+while application specific tags, including the payload itself, are decoded as
+*tags.RawTag because their format is defined by the data model. Use
+DecodePayloadWith() to decode them.
+*/
+func DecodePayload(payload []byte) (tags.ILTag, error) {
+	return tags.ILTagFromBytes(payloadTagFactory, payload)
+}
+
+/*
+Decodes the payload of the record into an ILTag tree. See DecodePayload().
+*/
+func (m *RecordModel) DecodePayload() (tags.ILTag, error) {
+	b, err := DecodeBytes(m.PayloadBytes)
+	if err != nil {
+		return nil, err
+	}
+	return DecodePayload(b)
+}
+
+/*
+Decodes the payload of the record using the given data model. See
+DecodePayloadWith().
+*/
+func (m *RecordModel) DecodePayloadWith(dm *DataModel) (map[string]any, error) {
+	b, err := DecodeBytes(m.PayloadBytes)
+	if err != nil {
+		return nil, err
+	}
+	return DecodePayloadWith(b, dm)
+}
+
+/*
+Decodes the payload of the record into v using the given data model. See
+UnmarshalPayload().
+*/
+func (m *RecordModel) UnmarshalPayload(dm *DataModel, v any) error {
+	b, err := DecodeBytes(m.PayloadBytes)
+	if err != nil {
+		return err
+	}
+	return UnmarshalPayload(b, dm, v)
+}
+
+/*
+Decodes the payload using the given data model and returns its fields indexed
+by their names. The data model can be found with AppsModel.FindDataModel().
+
+The fields are read in the order they appear in the data model. If the first
+field is named "Version", the fields introduced in later versions are treated
+as absent. Fields missing at the end of the payload, as in payloads created
+with older versions of the data model, are omitted, while extra data after the
+last known field is ignored.
+
+The values are converted as follows:
+
+  - Integers, floats, booleans and strings are returned as the corresponding
+    Go types. ILInt is returned as uint64 and signed ILInt as int64;
+  - Byte arrays, opaque fields, 128-bit floats and application specific tags
+    without sub fields are returned as []byte;
+  - Fields with sub fields are returned as map[string]any;
+  - ILTag arrays and sequences are returned as []any;
+  - ILInt arrays and OIDs are returned as []uint64;
+  - Big integers are returned as *big.Int and big decimals as strings;
+  - Versions are returned as "major.minor.revision.build" strings;
+  - Ranges are returned as map[string]any with "start" and "count";
+  - Dictionaries are returned as map[string]any or map[string]string;
+  - Null tags are returned as nil.
+
+Integer fields with an enumeration are returned as the name of their value.
+If the enumeration is a set of flags, the names of all flags are joined with
+"|". Unknown values are returned as decimal strings. Integer fields cast to
+DateTime are returned as time.Time, to TimeSpan as time.Duration and to
+Integer as int64. Both DateTime and TimeSpan are expected to be in .Net ticks
+of 100ns.
+
+It returns an error that wraps ErrPayloadMismatch if the payload does not
+match the data model.
+*/
+func DecodePayloadWith(payload []byte, dm *DataModel) (map[string]any, error) {
+	fields, err := decodePayloadTag(payload, dm)
+	if err != nil {
+		return nil, err
+	}
+	return namePayloadFields(dm.DataFields, fields), nil
+}
+
+/*
+Decodes the payload into v using the given data model. The value v must be a
+non-nil pointer, usually to a struct. The struct fields are matched to the
+fields of the data model as MarshalPayload() does, by their names ignoring the
+case or by the name set in their iltag struct tags. Fields of the data model
+without a matching struct field are ignored. Maps with string keys and empty
+interfaces receive the same values returned by DecodePayloadWith().
+
+Integer fields with an enumeration are stored as numbers into integer targets
+and as the names returned by DecodePayloadWith() into all others, such as
+strings and types that implement encoding.TextUnmarshaler. The other values are
+stored as encoding/json.Unmarshal() would do.
+*/
+func UnmarshalPayload(payload []byte, dm *DataModel, v any) error {
+	target := reflect.ValueOf(v)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return fmt.Errorf("cannot decode the payload into %T", v)
+	}
+	fields, err := decodePayloadTag(payload, dm)
+	if err != nil {
+		return err
+	}
+	return unmarshalPayloadFields(dm.DataFields, fields, target.Elem(), "")
+}
+
+/*
+Fields of a compound tag as read from the payload, before the enumerations and
+casts are applied.
+*/
+type payloadFields map[string]any
+
+// Elements of an ILTag array decoded with a data model.
+type payloadArray []any
+
+// Decodes the payload tag into the raw values of its fields.
+func decodePayloadTag(payload []byte, dm *DataModel) (payloadFields, error) {
+	tag, err := DecodePayload(payload)
+	if err != nil {
+		return nil, err
+	}
+	if tag.Id() != tags.TagID(dm.PayloadTagId) {
+		return nil, newPayloadFieldError("", "expected tag %d but found %d", dm.PayloadTagId, tag.Id())
+	}
+	raw, ok := tag.(*tags.RawTag)
+	if !ok {
+		return nil, newPayloadFieldError("", "the payload tag %d is not a compound tag", tag.Id())
+	}
+	return decodePayloadFields(raw.Payload, dm.DataFields, "")
+}
+
+// Returns true if the field holds the version of the payload.
+func isPayloadVersionField(index int, field *DataField) bool {
+	return index == 0 && strings.EqualFold(field.Name, "Version")
+}
+
+// Decodes the sequence of tags that holds the given fields.
+func decodePayloadFields(value []byte, fields []DataField, path string) (payloadFields, error) {
+	r := bytes.NewReader(value)
+	ret := make(payloadFields, len(fields))
+	version := int64(-1)
+	for i := range fields {
+		field := &fields[i]
+		p := fieldPath(path, field.Name)
+		if version >= 0 && int64(field.Version) > version {
+			continue
+		}
+		if r.Len() == 0 {
+			break
+		}
+		tag, err := tags.ILTagDeserialize(payloadTagFactory, r)
+		if err != nil {
+			return nil, newPayloadFieldError(p, "unable to read the tag: %v", err)
+		}
+		v, err := decodePayloadField(field, tag, p)
+		if err != nil {
+			return nil, err
+		}
+		ret[field.Name] = v
+		if isPayloadVersionField(i, field) {
+			if n, ok := payloadInt64(v); ok {
+				version = n
+			}
+		}
+	}
+	return ret, nil
+}
+
+// Decodes the value of a single field.
+func decodePayloadField(field *DataField, tag tags.ILTag, path string) (any, error) {
+	if tag.Id() == tags.IL_NULL_TAG_ID {
+		return nil, nil
+	}
+	if tag.Id() != tags.TagID(field.TagId) {
+		return nil, newPayloadFieldError(path, "expected tag %d but found %d", field.TagId, tag.Id())
+	}
+	if field.IsOpaque {
+		var b bytes.Buffer
+		if err := tag.SerializeValue(&b); err != nil {
+			return nil, err
+		}
+		return b.Bytes(), nil
+	}
+	var v any
+	var err error
+	if raw, ok := tag.(*tags.RawTag); ok && len(field.SubDataFields) > 0 && !tag.Id().Reserved() {
+		v, err = decodePayloadFields(raw.Payload, field.SubDataFields, path)
+	} else if array, ok := tag.(*impl.ILTagArrayTag); ok {
+		v, err = decodePayloadArray(field, array.Payload, path)
+	} else {
+		v, err = standardTagValue(tag)
+		if err != nil {
+			err = newPayloadFieldError(path, "%v", err)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Decodes the elements of an ILTag array field.
+func decodePayloadArray(field *DataField, elements []tags.ILTag, path string) (payloadArray, error) {
+	element := DataField{
+		TagId:         field.ElementTagId,
+		SubDataFields: field.SubDataFields,
+	}
+	ret := make(payloadArray, len(elements))
+	for i, e := range elements {
+		if field.ElementTagId == 0 {
+			element.TagId = int64(e.Id())
+		}
+		v, err := decodePayloadField(&element, e, fmt.Sprintf("%s[%d]", path, i))
+		if err != nil {
+			return nil, err
+		}
+		ret[i] = v
+	}
+	return ret, nil
+}
+
+// Applies the enumerations and casts of the fields to their raw values.
+func namePayloadFields(fields []DataField, raw payloadFields) map[string]any {
+	ret := make(map[string]any, len(raw))
+	for i := range fields {
+		field := &fields[i]
+		if v, ok := raw[field.Name]; ok {
+			ret[field.Name] = namePayloadValue(field, v)
+		}
+	}
+	return ret
+}
+
+// Applies the enumeration and the cast of the field to its raw value.
+func namePayloadValue(field *DataField, v any) any {
+	switch value := v.(type) {
+	case payloadFields:
+		return namePayloadFields(field.SubDataFields, value)
+	case payloadArray:
+		element := DataField{SubDataFields: field.SubDataFields}
+		ret := make([]any, len(value))
+		for i, e := range value {
+			ret[i] = namePayloadValue(&element, e)
+		}
+		return ret
+	}
+	return applyFieldSemantics(field, v)
+}
+
+// Allocates the pointers until the value they point to is reached.
+func allocPayloadTarget(target reflect.Value) reflect.Value {
+	for target.Kind() == reflect.Pointer {
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		target = target.Elem()
+	}
+	return target
+}
+
+// Stores the raw values of the fields into the target.
+func unmarshalPayloadFields(fields []DataField, raw payloadFields, target reflect.Value, path string) error {
+	target = allocPayloadTarget(target)
+	switch {
+	case target.Kind() == reflect.Interface && target.NumMethod() == 0:
+		target.Set(reflect.ValueOf(namePayloadFields(fields, raw)))
+		return nil
+	case target.Kind() == reflect.Map && target.Type().Key().Kind() == reflect.String:
+		if target.IsNil() {
+			target.Set(reflect.MakeMap(target.Type()))
+		}
+		for i := range fields {
+			field := &fields[i]
+			v, ok := raw[field.Name]
+			if !ok {
+				continue
+			}
+			value := reflect.New(target.Type().Elem()).Elem()
+			if err := unmarshalPayloadValue(field, v, value, fieldPath(path, field.Name)); err != nil {
+				return err
+			}
+			target.SetMapIndex(reflect.ValueOf(field.Name).Convert(target.Type().Key()), value)
+		}
+		return nil
+	case target.Kind() == reflect.Struct:
+		src, err := newPayloadStructSource(target, path)
+		if err != nil {
+			return err
+		}
+		for i := range fields {
+			field := &fields[i]
+			p := fieldPath(path, field.Name)
+			value, declared, found := src.lookup(field.Name)
+			if !found {
+				continue
+			}
+			if declared >= 0 && declared != field.TagId {
+				return newPayloadFieldError(p, "the iltag struct tag declares tag %d but the data model expects tag %d",
+					declared, field.TagId)
+			}
+			if v, ok := raw[field.Name]; ok {
+				if err := unmarshalPayloadValue(field, v, value, p); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	return newPayloadFieldError(path, "cannot decode a compound value into %s", target.Type())
+}
+
+// Stores the raw elements of an ILTag array into the target.
+func unmarshalPayloadArray(field *DataField, elements payloadArray, target reflect.Value, path string) error {
+	target = allocPayloadTarget(target)
+	element := DataField{SubDataFields: field.SubDataFields}
+	switch target.Kind() {
+	case reflect.Interface:
+		if target.NumMethod() == 0 {
+			target.Set(reflect.ValueOf(namePayloadValue(field, elements)))
+			return nil
+		}
+	case reflect.Slice:
+		s := reflect.MakeSlice(target.Type(), len(elements), len(elements))
+		for i, e := range elements {
+			if err := unmarshalPayloadValue(&element, e, s.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		target.Set(s)
+		return nil
+	case reflect.Array:
+		if target.Len() != len(elements) {
+			return newPayloadFieldError(path, "expected %d elements but found %d", target.Len(), len(elements))
+		}
+		for i, e := range elements {
+			if err := unmarshalPayloadValue(&element, e, target.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return newPayloadFieldError(path, "cannot decode an array into %s", target.Type())
+}
+
+// Stores the raw value of a field into the target.
+func unmarshalPayloadValue(field *DataField, v any, target reflect.Value, path string) error {
+	switch value := v.(type) {
+	case nil:
+		target.Set(reflect.Zero(target.Type()))
+		return nil
+	case payloadFields:
+		return unmarshalPayloadFields(field.SubDataFields, value, target, path)
+	case payloadArray:
+		return unmarshalPayloadArray(field, value, target, path)
+	}
+	if field.Enumeration != nil && len(field.Enumeration.Items) > 0 {
+		if n, ok := payloadUint64(v); ok && isPayloadIntegerType(target.Type()) {
+			return setPayloadInteger(n, allocPayloadTarget(target), path)
+		}
+	}
+	v = applyFieldSemantics(field, v)
+	if value := reflect.ValueOf(v); value.Type().AssignableTo(target.Type()) {
+		target.Set(value)
+		return nil
+	}
+	b, err := json.Marshal(v)
+	if err == nil {
+		err = json.Unmarshal(b, target.Addr().Interface())
+	}
+	if err != nil {
+		return newPayloadFieldError(path, "cannot decode %T into %s", v, target.Type())
+	}
+	return nil
+}
+
+// Returns true if the type, or the type it points to, is an integer.
+func isPayloadIntegerType(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+// Stores the value into an integer target, checking if it fits.
+func setPayloadInteger(n uint64, target reflect.Value, path string) error {
+	if target.CanUint() {
+		if target.OverflowUint(n) {
+			return newPayloadFieldError(path, "the value %d does not fit in %s", n, target.Type())
+		}
+		target.SetUint(n)
+		return nil
+	}
+	if n > math.MaxInt64 || target.OverflowInt(int64(n)) {
+		return newPayloadFieldError(path, "the value %d does not fit in %s", n, target.Type())
+	}
+	target.SetInt(int64(n))
+	return nil
+}
+
+// Converts a tag into a Go value without any data model.
+func standardTagValue(tag tags.ILTag) (any, error) {
+	switch t := tag.(type) {
+	case *impl.NullTag:
+		return nil, nil
+	case *impl.BoolTag:
+		return t.Payload, nil
+	case *impl.Int8Tag:
+		return t.Payload, nil
+	case *impl.UInt8Tag:
+		return t.Payload, nil
+	case *impl.Int16Tag:
+		return t.Payload, nil
+	case *impl.UInt16Tag:
+		return t.Payload, nil
+	case *impl.Int32Tag:
+		return t.Payload, nil
+	case *impl.UInt32Tag:
+		return t.Payload, nil
+	case *impl.Int64Tag:
+		return t.Payload, nil
+	case *impl.UInt64Tag:
+		return t.Payload, nil
+	case *impl.ILIntTag:
+		return t.Payload, nil
+	case *impl.SignedILIntTag:
+		return t.Payload, nil
+	case *impl.Float32Tag:
+		return t.Payload, nil
+	case *impl.Float64Tag:
+		return t.Payload, nil
+	case *impl.Float128Tag:
+		return append([]byte(nil), t.Payload[:]...), nil
+	case *tags.RawTag:
+		return t.Payload, nil
+	case *impl.StringTag:
+		return t.Payload, nil
+	case *impl.BigIntTag:
+		return bigIntFromBytes(t.Payload), nil
+	case *impl.BigDecTag:
+		return bigDecString(bigIntFromBytes(t.Payload), t.Scale), nil
+	case *impl.ILIntArrayTag:
+		return t.Payload, nil
+	case *impl.ILTagArrayTag:
+		return standardTagValues(t.Payload)
+	case *impl.ILTagSequenceTag:
+		return standardTagValues(t.Payload)
+	case *impl.RangeTag:
+		return map[string]any{"start": t.Start, "count": t.Count}, nil
+	case *impl.VersionTag:
+		return fmt.Sprintf("%d.%d.%d.%d", t.Major, t.Minor, t.Revision, t.Build), nil
+	case *impl.StringDictionaryTag:
+		ret := make(map[string]string, t.Map.Size())
+		for _, e := range t.Map.Entries() {
+			ret[e.Key] = e.Value
+		}
+		return ret, nil
+	case *impl.DictionaryTag:
+		ret := make(map[string]any, t.Map.Size())
+		for _, e := range t.Map.Entries() {
+			v, err := standardTagValue(e.Value)
+			if err != nil {
+				return nil, err
+			}
+			ret[e.Key] = v
+		}
+		return ret, nil
+	default:
+		return nil, fmt.Errorf("unsupported tag %d", tag.Id())
+	}
+}
+
+// Converts a list of tags into Go values.
+func standardTagValues(l []tags.ILTag) ([]any, error) {
+	ret := make([]any, len(l))
+	for i, t := range l {
+		v, err := standardTagValue(t)
+		if err != nil {
+			return nil, err
+		}
+		ret[i] = v
+	}
+	return ret, nil
+}
+
+// Converts a big endian two's complement integer into a big.Int.
+func bigIntFromBytes(b []byte) *big.Int {
+	n := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		n.Sub(n, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+	}
+	return n
+}
+
+// Formats a big decimal as a string.
+func bigDecString(unscaled *big.Int, scale int32) string {
+	if scale <= 0 {
+		return new(big.Int).Mul(unscaled, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-scale)), nil)).String()
+	}
+	s := new(big.Int).Abs(unscaled).String()
+	if len(s) <= int(scale) {
+		s = strings.Repeat("0", int(scale)-len(s)+1) + s
+	}
+	s = s[:len(s)-int(scale)] + "." + s[len(s)-int(scale):]
+	if unscaled.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// Number of .Net ticks at 1970-01-01.
+const dotNetUnixEpochTicks = 621355968000000000
+
+// Applies the enumeration and the cast of the field to the value.
+func applyFieldSemantics(field *DataField, v any) any {
+	if field.Enumeration != nil && len(field.Enumeration.Items) > 0 {
+		if n, ok := payloadUint64(v); ok {
+			return field.Enumeration.Format(n, field.EnumerationAsFlags)
+		}
+		return v
+	}
+	if field.Cast == nil {
+		return v
+	}
+	n, ok := payloadInt64(v)
+	if !ok {
+		return v
+	}
+	switch *field.Cast {
+	case DATE_TIME_CastType:
+		ticks := n - dotNetUnixEpochTicks
+		return time.Unix(ticks/10000000, (ticks%10000000)*100).UTC()
+	case TIME_SPAN_CastType:
+		return time.Duration(n) * 100
+	case INTEGER_CastType:
+		return n
+	}
+	return v
+}
+
+// Converts any integer value into uint64.
+func payloadUint64(v any) (uint64, bool) {
+	switch n := v.(type) {
+	case uint8:
+		return uint64(n), true
+	case uint16:
+		return uint64(n), true
+	case uint32:
+		return uint64(n), true
+	case uint64:
+		return n, true
+	case int8, int16, int32, int64:
+		i, _ := payloadInt64(n)
+		if i < 0 {
+			return 0, false
+		}
+		return uint64(i), true
+	}
+	return 0, false
+}
+
+// Converts any integer value into int64.
+func payloadInt64(v any) (int64, bool) {
+	switch n := v.(type) {
+	case int8:
+		return int64(n), true
+	case int16:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case int64:
+		return n, true
+	case uint8:
+		return int64(n), true
+	case uint16:
+		return int64(n), true
+	case uint32:
+		return int64(n), true
+	case uint64:
+		return int64(n), true
+	}
+	return 0, false
+}
@@ -0,0 +1,839 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package models
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/interlockledger/go-iltags/tags"
+	"github.com/interlockledger/go-iltags/tags/impl"
+)
+
+/*
+Encodes v as a payload described by the given data model. The result is the
+serialized payload tag, ready to be used as the payload of a NewRecordModel.
+
+The value v must be a struct, a map with string keys or a pointer to one of
+them. Each field of the data model is taken from the struct field or map entry
+with the same name, ignoring the case. The name of a struct field and the tag
+id it must have can be set with the iltag struct tag:
+
+	type Payload struct {
+		Version uint64 `iltag:"tagId=10"`
+		Title   string `iltag:"name=Name,tagId=17"`
+		Notes   string `iltag:"-"`
+	}
+
+Values are converted into the tag of the field as follows:
+
+  - Integer tags accept any integer type as long as the value fits in the tag;
+  - Float tags accept float32 and float64;
+  - 128-bit floats accept [16]byte and []byte with 16 bytes;
+  - Byte arrays, opaque fields and application specific tags without sub
+    fields accept []byte with the value of the tag;
+  - Fields with sub fields accept structs and maps with string keys;
+  - ILTag arrays and sequences accept slices and arrays. If the field does not
+    define the tag of the elements, it is inferred from their Go types;
+  - ILInt arrays and OIDs accept slices of integers;
+  - Big integers accept *big.Int and integers, big decimals accept strings
+    such as "-12.34";
+  - Versions accept "major.minor.revision.build" strings;
+  - Ranges accept structs and maps with "start" and "count";
+  - String dictionaries accept maps of strings and dictionaries accept maps
+    with string keys;
+  - Nil pointers, nil interfaces and nil maps and slices are encoded as null
+    tags, as well as empty big decimals and versions;
+  - Values that implement tags.ILTag are written as they are if they have the
+    expected tag id.
+
+Fields with an enumeration also accept the names of their values, using "|"
+to join flags, and integer fields cast to DateTime and TimeSpan also accept
+time.Time and time.Duration respectively. This is the inverse of the
+conversions made by DecodePayloadWith(), thus UnmarshalPayload() decodes the
+result into a value of the same type.
+
+If the first field is named "Version", its value sets the version of the
+payload. It must not be greater than the version of the data model, and the
+fields introduced in later versions must be left empty as they are not
+written. Deprecated fields may be omitted and are written as null.
+
+It returns an error that wraps ErrPayloadMismatch if the value does not match
+the data model.
+*/
+func MarshalPayload(v any, dm *DataModel) ([]byte, error) {
+	id := tags.TagID(dm.PayloadTagId)
+	if id.Reserved() {
+		return nil, newPayloadFieldError("", "the payload tag %d is reserved", id)
+	}
+	value, err := encodePayloadFields(reflect.ValueOf(v), dm.DataFields, int64(dm.Version), "")
+	if err != nil {
+		return nil, err
+	}
+	raw := tags.NewRawTag(id)
+	raw.Payload = value
+	return tags.ILTagToBytes(raw)
+}
+
+/*
+Sets the payload of the record by encoding v with the given data model. See
+MarshalPayload().
+*/
+func (m *NewRecordModel) SetPayload(v any, dm *DataModel) error {
+	b, err := MarshalPayload(v, dm)
+	if err != nil {
+		return err
+	}
+	m.PayloadBytes = EncodeBytes(b)
+	return nil
+}
+
+var iltagType = reflect.TypeOf((*tags.ILTag)(nil)).Elem()
+var timeType = reflect.TypeOf(time.Time{})
+var durationType = reflect.TypeOf(time.Duration(0))
+var bigIntType = reflect.TypeOf(big.Int{})
+
+// Source of the values of a compound field.
+type payloadSource interface {
+	// Returns the value of the field and the tag id declared for it, or -1.
+	lookup(name string) (reflect.Value, int64, bool)
+	// Returns the names of the entries that were not looked up.
+	unused() []string
+}
+
+// Field of a struct used as a payload source.
+type payloadStructField struct {
+	index []int
+	name  string
+	tagId int64
+	used  bool
+}
+
+// Payload source backed by a struct.
+type payloadStructSource struct {
+	value  reflect.Value
+	fields map[string]*payloadStructField
+}
+
+// Creates a payload source from a struct, parsing the iltag struct tags.
+func newPayloadStructSource(value reflect.Value, path string) (*payloadStructSource, error) {
+	s := &payloadStructSource{value: value, fields: make(map[string]*payloadStructField)}
+	if err := s.addFields(value.Type(), nil, path); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Adds the fields of the struct type, including the ones of embedded structs.
+func (s *payloadStructSource) addFields(t reflect.Type, index []int, path string) error {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, hasTag := sf.Tag.Lookup("iltag")
+		if tag == "-" {
+			continue
+		}
+		fieldIndex := append(append([]int(nil), index...), i)
+		if sf.Anonymous && !hasTag && sf.Type.Kind() == reflect.Struct {
+			if err := s.addFields(sf.Type, fieldIndex, path); err != nil {
+				return err
+			}
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		f := &payloadStructField{index: fieldIndex, name: sf.Name, tagId: -1}
+		name := sf.Name
+		if tag != "" {
+			for _, option := range strings.Split(tag, ",") {
+				key, value, _ := strings.Cut(strings.TrimSpace(option), "=")
+				switch key {
+				case "name":
+					name = value
+				case "tagId":
+					id, err := strconv.ParseUint(value, 10, 64)
+					if err != nil {
+						return newPayloadFieldError(fieldPath(path, sf.Name), "invalid tag id %q in the iltag struct tag", value)
+					}
+					f.tagId = int64(id)
+				default:
+					return newPayloadFieldError(fieldPath(path, sf.Name), "unknown option %q in the iltag struct tag", option)
+				}
+			}
+		}
+		key := strings.ToLower(name)
+		if other, ok := s.fields[key]; ok {
+			return newPayloadFieldError(fieldPath(path, sf.Name), "the struct fields %s and %s have the same name", other.name, sf.Name)
+		}
+		s.fields[key] = f
+	}
+	return nil
+}
+
+func (s *payloadStructSource) lookup(name string) (reflect.Value, int64, bool) {
+	f, ok := s.fields[strings.ToLower(name)]
+	if !ok {
+		return reflect.Value{}, -1, false
+	}
+	f.used = true
+	return s.value.FieldByIndex(f.index), f.tagId, true
+}
+
+func (s *payloadStructSource) unused() []string {
+	var ret []string
+	for _, f := range s.fields {
+		if !f.used {
+			ret = append(ret, f.name)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// Payload source backed by a map with string keys.
+type payloadMapSource struct {
+	value reflect.Value
+	used  map[string]bool
+}
+
+func (s *payloadMapSource) lookup(name string) (reflect.Value, int64, bool) {
+	for _, k := range s.value.MapKeys() {
+		if strings.EqualFold(k.String(), name) {
+			s.used[k.String()] = true
+			return s.value.MapIndex(k), -1, true
+		}
+	}
+	return reflect.Value{}, -1, false
+}
+
+func (s *payloadMapSource) unused() []string {
+	var ret []string
+	for _, k := range s.value.MapKeys() {
+		if !s.used[k.String()] {
+			ret = append(ret, k.String())
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// Removes pointers and interfaces from the value.
+func indirectPayloadValue(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// Returns true if the value is absent or nil.
+func isNilPayloadValue(v reflect.Value) bool {
+	v = indirectPayloadValue(v)
+	if !v.IsValid() {
+		return true
+	}
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice:
+		return v.IsNil()
+	}
+	return false
+}
+
+// Creates the source of the values of a compound field.
+func newPayloadSource(v reflect.Value, path string) (payloadSource, error) {
+	v = indirectPayloadValue(v)
+	if !v.IsValid() {
+		return nil, newPayloadFieldError(path, "nil value")
+	}
+	switch {
+	case v.Kind() == reflect.Struct:
+		return newPayloadStructSource(v, path)
+	case v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String:
+		return &payloadMapSource{value: v, used: make(map[string]bool)}, nil
+	}
+	return nil, newPayloadFieldError(path, "cannot encode %s as a compound value", v.Type())
+}
+
+// Encodes the sequence of tags that holds the given fields.
+func encodePayloadFields(v reflect.Value, fields []DataField, maxVersion int64, path string) ([]byte, error) {
+	src, err := newPayloadSource(v, path)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	version := int64(-1)
+	for i := range fields {
+		field := &fields[i]
+		p := fieldPath(path, field.Name)
+		value, declared, found := src.lookup(field.Name)
+		if version >= 0 && int64(field.Version) > version {
+			if found && !indirectPayloadValue(value).IsZero() {
+				return nil, newPayloadFieldError(p, "the field was introduced in version %d but the payload has version %d",
+					field.Version, version)
+			}
+			continue
+		}
+		if !found && !field.IsDeprecated {
+			return nil, newPayloadFieldError(p, "missing value")
+		}
+		if declared >= 0 && declared != field.TagId {
+			return nil, newPayloadFieldError(p, "the iltag struct tag declares tag %d but the data model expects tag %d",
+				declared, field.TagId)
+		}
+		tag, err := encodePayloadField(field, value, p)
+		if err != nil {
+			return nil, err
+		}
+		if err := tags.ILTagSeralize(tag, &b); err != nil {
+			return nil, err
+		}
+		if isPayloadVersionField(i, field) {
+			if n, ok := payloadInt64(reflectIntegerValue(indirectPayloadValue(value))); ok {
+				version = n
+				if maxVersion > 0 && version > maxVersion {
+					return nil, newPayloadFieldError(p, "version %d is not supported by the data model version %d",
+						version, maxVersion)
+				}
+			}
+		}
+	}
+	if unused := src.unused(); len(unused) > 0 {
+		return nil, newPayloadFieldError(fieldPath(path, unused[0]), "the field is not in the data model")
+	}
+	return b.Bytes(), nil
+}
+
+// Returns the integer inside the value as int64 or uint64, or nil.
+func reflectIntegerValue(v reflect.Value) any {
+	switch {
+	case !v.IsValid():
+		return nil
+	case v.CanInt():
+		return v.Int()
+	case v.CanUint():
+		return v.Uint()
+	}
+	return nil
+}
+
+// Encodes the value of a single field.
+func encodePayloadField(field *DataField, v reflect.Value, path string) (tags.ILTag, error) {
+	id := tags.TagID(field.TagId)
+	if v.IsValid() && v.Type().Implements(iltagType) && !(v.Kind() == reflect.Pointer && v.IsNil()) {
+		tag := v.Interface().(tags.ILTag)
+		if tag.Id() != id {
+			return nil, newPayloadFieldError(path, "expected tag %d but found %d", id, tag.Id())
+		}
+		return tag, nil
+	}
+	if isNilPayloadValue(v) {
+		return impl.NewStdNullTag(), nil
+	}
+	v = indirectPayloadValue(v)
+	if field.IsOpaque || (!id.Reserved() && len(field.SubDataFields) == 0) {
+		b, err := payloadBytes(v, path, id)
+		if err != nil {
+			return nil, err
+		}
+		raw := tags.NewRawTag(id)
+		raw.Payload = b
+		return raw, nil
+	}
+	if !id.Reserved() {
+		b, err := encodePayloadFields(v, field.SubDataFields, 0, path)
+		if err != nil {
+			return nil, err
+		}
+		raw := tags.NewRawTag(id)
+		raw.Payload = b
+		return raw, nil
+	}
+	v, err := applyFieldEncoding(field, v, path)
+	if err != nil {
+		return nil, err
+	}
+	return encodeStandardTag(field, v, path)
+}
+
+// Converts enumeration names and casts into integers.
+func applyFieldEncoding(field *DataField, v reflect.Value, path string) (reflect.Value, error) {
+	if field.Enumeration != nil && len(field.Enumeration.Items) > 0 && v.Kind() == reflect.String {
+		n, err := field.Enumeration.Parse(v.String(), field.EnumerationAsFlags)
+		if err != nil {
+			return v, newPayloadFieldError(path, "%v", err)
+		}
+		return reflect.ValueOf(n), nil
+	}
+	if field.Cast == nil {
+		return v, nil
+	}
+	switch {
+	case *field.Cast == DATE_TIME_CastType && v.Type() == timeType:
+		t := v.Interface().(time.Time)
+		return reflect.ValueOf(t.Unix()*10000000 + int64(t.Nanosecond()/100) + dotNetUnixEpochTicks), nil
+	case *field.Cast == TIME_SPAN_CastType && v.Type() == durationType:
+		return reflect.ValueOf(int64(v.Interface().(time.Duration) / 100)), nil
+	}
+	return v, nil
+}
+
+// Returns the error of a value that cannot be encoded as the given tag.
+func payloadTypeError(v reflect.Value, path string, id tags.TagID) error {
+	return newPayloadFieldError(path, "cannot encode %s as tag %d", v.Type(), id)
+}
+
+// Returns the bytes of a []byte or [N]byte value.
+func payloadBytes(v reflect.Value, path string, id tags.TagID) ([]byte, error) {
+	switch {
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+		return v.Bytes(), nil
+	case v.Kind() == reflect.Array && v.Type().Elem().Kind() == reflect.Uint8:
+		b := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(b), v)
+		return b, nil
+	}
+	return nil, payloadTypeError(v, path, id)
+}
+
+// Converts an integer value into int64, checking if it fits in the given bits.
+func payloadSigned(v reflect.Value, bits int, path string, id tags.TagID) (int64, error) {
+	var n int64
+	switch {
+	case v.CanInt():
+		n = v.Int()
+	case v.CanUint():
+		if v.Uint() > math.MaxInt64 {
+			return 0, newPayloadFieldError(path, "the value %d does not fit in tag %d", v.Uint(), id)
+		}
+		n = int64(v.Uint())
+	default:
+		return 0, payloadTypeError(v, path, id)
+	}
+	if bits < 64 && (n < -1<<(bits-1) || n >= 1<<(bits-1)) {
+		return 0, newPayloadFieldError(path, "the value %d does not fit in tag %d", n, id)
+	}
+	return n, nil
+}
+
+// Converts an integer value into uint64, checking if it fits in the given bits.
+func payloadUnsigned(v reflect.Value, bits int, path string, id tags.TagID) (uint64, error) {
+	var n uint64
+	switch {
+	case v.CanInt():
+		if v.Int() < 0 {
+			return 0, newPayloadFieldError(path, "the value %d does not fit in tag %d", v.Int(), id)
+		}
+		n = uint64(v.Int())
+	case v.CanUint():
+		n = v.Uint()
+	default:
+		return 0, payloadTypeError(v, path, id)
+	}
+	if bits < 64 && n >= 1<<bits {
+		return 0, newPayloadFieldError(path, "the value %d does not fit in tag %d", n, id)
+	}
+	return n, nil
+}
+
+// Returns the size in bits of the integer tags.
+func integerTagBits(id tags.TagID) int {
+	switch id {
+	case tags.IL_INT8_TAG_ID, tags.IL_UINT8_TAG_ID:
+		return 8
+	case tags.IL_INT16_TAG_ID, tags.IL_UINT16_TAG_ID:
+		return 16
+	case tags.IL_INT32_TAG_ID, tags.IL_UINT32_TAG_ID:
+		return 32
+	}
+	return 64
+}
+
+// Returns the standard tag used to encode values of the given Go type.
+func standardTagIdOf(v reflect.Value) (tags.TagID, bool) {
+	switch v.Kind() {
+	case reflect.Bool:
+		return tags.IL_BOOL_TAG_ID, true
+	case reflect.Int8:
+		return tags.IL_INT8_TAG_ID, true
+	case reflect.Uint8:
+		return tags.IL_UINT8_TAG_ID, true
+	case reflect.Int16:
+		return tags.IL_INT16_TAG_ID, true
+	case reflect.Uint16:
+		return tags.IL_UINT16_TAG_ID, true
+	case reflect.Int32:
+		return tags.IL_INT32_TAG_ID, true
+	case reflect.Uint32:
+		return tags.IL_UINT32_TAG_ID, true
+	case reflect.Int, reflect.Int64:
+		return tags.IL_INT64_TAG_ID, true
+	case reflect.Uint, reflect.Uint64:
+		return tags.IL_UINT64_TAG_ID, true
+	case reflect.Float32:
+		return tags.IL_BIN32_TAG_ID, true
+	case reflect.Float64:
+		return tags.IL_BIN64_TAG_ID, true
+	case reflect.String:
+		return tags.IL_STRING_TAG_ID, true
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return tags.IL_BYTES_TAG_ID, true
+		}
+	}
+	return 0, false
+}
+
+// Encodes a value whose tag is inferred from its Go type.
+func encodeInferredTag(v reflect.Value, path string) (tags.ILTag, error) {
+	if v.IsValid() && v.Type().Implements(iltagType) && !(v.Kind() == reflect.Pointer && v.IsNil()) {
+		return v.Interface().(tags.ILTag), nil
+	}
+	if isNilPayloadValue(v) {
+		return impl.NewStdNullTag(), nil
+	}
+	v = indirectPayloadValue(v)
+	id, ok := standardTagIdOf(v)
+	if !ok {
+		return nil, newPayloadFieldError(path, "cannot infer the tag of %s", v.Type())
+	}
+	return encodeStandardTag(&DataField{TagId: int64(id)}, v, path)
+}
+
+// Encodes the value as one of the standard tags.
+func encodeStandardTag(field *DataField, v reflect.Value, path string) (tags.ILTag, error) {
+	id := tags.TagID(field.TagId)
+	switch id {
+	case tags.IL_NULL_TAG_ID:
+		return impl.NewStdNullTag(), nil
+	case tags.IL_BOOL_TAG_ID:
+		if v.Kind() != reflect.Bool {
+			return nil, payloadTypeError(v, path, id)
+		}
+		t := impl.NewStdBoolTag()
+		t.Payload = v.Bool()
+		return t, nil
+	case tags.IL_INT8_TAG_ID, tags.IL_INT16_TAG_ID, tags.IL_INT32_TAG_ID, tags.IL_INT64_TAG_ID,
+		tags.IL_SIGNED_ILINT_TAG_ID:
+		n, err := payloadSigned(v, integerTagBits(id), path, id)
+		if err != nil {
+			return nil, err
+		}
+		switch id {
+		case tags.IL_INT8_TAG_ID:
+			t := impl.NewStdInt8Tag()
+			t.Payload = int8(n)
+			return t, nil
+		case tags.IL_INT16_TAG_ID:
+			t := impl.NewStdInt16Tag()
+			t.Payload = int16(n)
+			return t, nil
+		case tags.IL_INT32_TAG_ID:
+			t := impl.NewStdInt32Tag()
+			t.Payload = int32(n)
+			return t, nil
+		case tags.IL_INT64_TAG_ID:
+			t := impl.NewStdInt64Tag()
+			t.Payload = n
+			return t, nil
+		default:
+			t := impl.NewStdSignedILIntTag()
+			t.Payload = n
+			return t, nil
+		}
+	case tags.IL_UINT8_TAG_ID, tags.IL_UINT16_TAG_ID, tags.IL_UINT32_TAG_ID, tags.IL_UINT64_TAG_ID,
+		tags.IL_ILINT_TAG_ID:
+		n, err := payloadUnsigned(v, integerTagBits(id), path, id)
+		if err != nil {
+			return nil, err
+		}
+		switch id {
+		case tags.IL_UINT8_TAG_ID:
+			t := impl.NewStdUInt8Tag()
+			t.Payload = uint8(n)
+			return t, nil
+		case tags.IL_UINT16_TAG_ID:
+			t := impl.NewStdUInt16Tag()
+			t.Payload = uint16(n)
+			return t, nil
+		case tags.IL_UINT32_TAG_ID:
+			t := impl.NewStdUInt32Tag()
+			t.Payload = uint32(n)
+			return t, nil
+		case tags.IL_UINT64_TAG_ID:
+			t := impl.NewStdUInt64Tag()
+			t.Payload = n
+			return t, nil
+		default:
+			t := impl.NewStdILIntTag()
+			t.Payload = n
+			return t, nil
+		}
+	case tags.IL_BIN32_TAG_ID:
+		if !v.CanFloat() {
+			return nil, payloadTypeError(v, path, id)
+		}
+		t := impl.NewStdFloat32Tag()
+		t.Payload = float32(v.Float())
+		return t, nil
+	case tags.IL_BIN64_TAG_ID:
+		if !v.CanFloat() {
+			return nil, payloadTypeError(v, path, id)
+		}
+		t := impl.NewStdFloat64Tag()
+		t.Payload = v.Float()
+		return t, nil
+	case tags.IL_BIN128_TAG_ID:
+		b, err := payloadBytes(v, path, id)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) != 16 {
+			return nil, newPayloadFieldError(path, "expected 16 bytes but found %d", len(b))
+		}
+		t := impl.NewStdFloat128Tag()
+		copy(t.Payload[:], b)
+		return t, nil
+	case tags.IL_BYTES_TAG_ID:
+		b, err := payloadBytes(v, path, id)
+		if err != nil {
+			return nil, err
+		}
+		t := impl.NewStdBytesTag()
+		t.Payload = b
+		return t, nil
+	case tags.IL_STRING_TAG_ID:
+		if v.Kind() != reflect.String {
+			return nil, payloadTypeError(v, path, id)
+		}
+		t := impl.NewStdStringTag()
+		t.Payload = v.String()
+		return t, nil
+	case tags.IL_BINT_TAG_ID:
+		var n *big.Int
+		if v.Type() == bigIntType {
+			bi := v.Interface().(big.Int)
+			n = &bi
+		} else if v.CanInt() {
+			n = big.NewInt(v.Int())
+		} else if v.CanUint() {
+			n = new(big.Int).SetUint64(v.Uint())
+		} else {
+			return nil, payloadTypeError(v, path, id)
+		}
+		t := impl.NewStdBigIntTag()
+		t.Payload = bigIntToBytes(n)
+		return t, nil
+	case tags.IL_BDEC_TAG_ID:
+		if v.Kind() != reflect.String {
+			return nil, payloadTypeError(v, path, id)
+		}
+		if v.String() == "" {
+			return impl.NewStdNullTag(), nil
+		}
+		n, scale, err := parseBigDec(v.String())
+		if err != nil {
+			return nil, newPayloadFieldError(path, "%v", err)
+		}
+		t := impl.NewStdBigDecTag()
+		t.Payload = bigIntToBytes(n)
+		t.Scale = scale
+		return t, nil
+	case tags.IL_ILINTARRAY_TAG_ID, tags.IL_OID_TAG_ID:
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return nil, payloadTypeError(v, path, id)
+		}
+		values := make([]uint64, v.Len())
+		for i := range values {
+			n, err := payloadUnsigned(indirectPayloadValue(v.Index(i)), 64, fmt.Sprintf("%s[%d]", path, i), id)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = n
+		}
+		t := impl.NewILIntArrayTag(id)
+		t.Payload = values
+		return t, nil
+	case tags.IL_ILTAGARRAY_TAG_ID, tags.IL_ILTAGSEQ_TAG_ID:
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return nil, payloadTypeError(v, path, id)
+		}
+		elements, err := encodePayloadArray(field, v, path)
+		if err != nil {
+			return nil, err
+		}
+		if id == tags.IL_ILTAGSEQ_TAG_ID {
+			t := impl.NewStdILTagSequenceTag()
+			t.Payload = elements
+			return t, nil
+		}
+		t := impl.NewStdILTagArrayTag()
+		t.Payload = elements
+		return t, nil
+	case tags.IL_RANGE_TAG_ID:
+		src, err := newPayloadSource(v, path)
+		if err != nil {
+			return nil, err
+		}
+		start, _, okStart := src.lookup("start")
+		count, _, okCount := src.lookup("count")
+		if !okStart || !okCount {
+			return nil, newPayloadFieldError(path, "a range requires start and count")
+		}
+		t := impl.NewStdRangeTag()
+		if t.Start, err = payloadUnsigned(indirectPayloadValue(start), 64, fieldPath(path, "start"), id); err != nil {
+			return nil, err
+		}
+		c, err := payloadUnsigned(indirectPayloadValue(count), 16, fieldPath(path, "count"), id)
+		if err != nil {
+			return nil, err
+		}
+		t.Count = uint16(c)
+		return t, nil
+	case tags.IL_VERSION_TAG_ID:
+		if v.Kind() != reflect.String {
+			return nil, payloadTypeError(v, path, id)
+		}
+		if v.String() == "" {
+			return impl.NewStdNullTag(), nil
+		}
+		parts := strings.Split(v.String(), ".")
+		if len(parts) != 4 {
+			return nil, newPayloadFieldError(path, "invalid version %q", v.String())
+		}
+		var values [4]int32
+		for i, p := range parts {
+			n, err := strconv.ParseInt(p, 10, 32)
+			if err != nil {
+				return nil, newPayloadFieldError(path, "invalid version %q", v.String())
+			}
+			values[i] = int32(n)
+		}
+		t := impl.NewStdVersionTag()
+		t.Major, t.Minor, t.Revision, t.Build = values[0], values[1], values[2], values[3]
+		return t, nil
+	case tags.IL_STRING_DICTIONARY_TAG_ID:
+		if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+			return nil, payloadTypeError(v, path, id)
+		}
+		t := impl.NewStdStringDictionaryTag()
+		for _, k := range sortedMapKeys(v) {
+			value := indirectPayloadValue(v.MapIndex(k))
+			if !value.IsValid() || value.Kind() != reflect.String {
+				return nil, newPayloadFieldError(fieldPath(path, k.String()), "expected a string")
+			}
+			t.Map.Put(k.String(), value.String())
+		}
+		return t, nil
+	case tags.IL_DICTIONARY_TAG_ID:
+		if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+			return nil, payloadTypeError(v, path, id)
+		}
+		t := impl.NewStdDictionaryTag()
+		for _, k := range sortedMapKeys(v) {
+			value, err := encodeInferredTag(v.MapIndex(k), fieldPath(path, k.String()))
+			if err != nil {
+				return nil, err
+			}
+			t.Map.Put(k.String(), value)
+		}
+		return t, nil
+	}
+	return nil, newPayloadFieldError(path, "unsupported tag %d", id)
+}
+
+// Encodes the elements of an ILTag array or sequence.
+func encodePayloadArray(field *DataField, v reflect.Value, path string) ([]tags.ILTag, error) {
+	element := DataField{
+		TagId:         field.ElementTagId,
+		SubDataFields: field.SubDataFields,
+	}
+	ret := make([]tags.ILTag, v.Len())
+	for i := range ret {
+		p := fmt.Sprintf("%s[%d]", path, i)
+		var tag tags.ILTag
+		var err error
+		if field.ElementTagId == 0 {
+			tag, err = encodeInferredTag(v.Index(i), p)
+		} else {
+			tag, err = encodePayloadField(&element, v.Index(i), p)
+		}
+		if err != nil {
+			return nil, err
+		}
+		ret[i] = tag
+	}
+	return ret, nil
+}
+
+// Returns the keys of a map sorted, so the encoding is deterministic.
+func sortedMapKeys(v reflect.Value) []reflect.Value {
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+	return keys
+}
+
+// Converts a big.Int into a big endian two's complement integer.
+func bigIntToBytes(n *big.Int) []byte {
+	var b []byte
+	if n.Sign() >= 0 {
+		b = n.Bytes()
+	} else {
+		b = new(big.Int).Not(n).Bytes()
+	}
+	if len(b) == 0 || b[0]&0x80 != 0 {
+		b = append([]byte{0}, b...)
+	}
+	if n.Sign() < 0 {
+		for i := range b {
+			b[i] = ^b[i]
+		}
+	}
+	return b
+}
+
+// Parses a decimal such as "-12.34" into its unscaled value and scale.
+func parseBigDec(s string) (*big.Int, int32, error) {
+	digits, fraction, _ := strings.Cut(s, ".")
+	n, ok := new(big.Int).SetString(digits+fraction, 10)
+	if !ok || strings.ContainsAny(fraction, "+-") {
+		return nil, 0, fmt.Errorf("invalid decimal %q", s)
+	}
+	return n, int32(len(fraction)), nil
+}
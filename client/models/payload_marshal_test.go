@@ -0,0 +1,231 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package models
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/interlockledger/go-iltags/tags"
+	"github.com/interlockledger/go-iltags/tags/impl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPayloadItem struct {
+	Id    uint64
+	Label string `iltag:"tagId=17"`
+}
+
+type testPayloadStruct struct {
+	Version   uint64 `iltag:"tagId=10"`
+	Title     string `iltag:"name=Name"`
+	Kind      string
+	Flags     uint64
+	CreatedAt time.Time
+	Secret    []byte
+	Items     []testPayloadItem
+	Notes     string `iltag:"-"`
+}
+
+func TestMarshalPayload(t *testing.T) {
+	dm := testDataModel()
+	expected := testPayload(t, 2, "test")
+
+	// Round trip
+	decoded, err := DecodePayloadWith(expected, dm)
+	require.NoError(t, err)
+	b, err := MarshalPayload(decoded, dm)
+	require.NoError(t, err)
+	assert.Equal(t, expected, b)
+
+	v := testPayloadStruct{
+		Version:   2,
+		Title:     "test",
+		Kind:      "Complex",
+		Flags:     5,
+		CreatedAt: time.Unix(1600000000, 0),
+		Secret:    []byte{1, 2, 3},
+		Items:     []testPayloadItem{{1, "a"}, {2, "b"}},
+		Notes:     "ignored",
+	}
+	b, err = MarshalPayload(&v, dm)
+	require.NoError(t, err)
+	assert.Equal(t, expected, b)
+
+	// The iltag struct tags are also used to decode the payload.
+	var back testPayloadStruct
+	require.NoError(t, UnmarshalPayload(b, dm, &back))
+	assert.Equal(t, "test", back.Title)
+	assert.Equal(t, "", back.Notes)
+	assert.True(t, v.CreatedAt.Equal(back.CreatedAt))
+	back.CreatedAt = v.CreatedAt
+	v.Notes = ""
+	assert.Equal(t, v, back)
+
+	// Fields of later versions are not written.
+	v = testPayloadStruct{Version: 1, Title: "old", Kind: "None", Flags: 0}
+	b, err = MarshalPayload(v, dm)
+	require.NoError(t, err)
+	decoded, err = DecodePayloadWith(b, dm)
+	require.NoError(t, err)
+	assert.NotContains(t, decoded, "Items")
+	assert.Equal(t, "old", decoded["Name"])
+	assert.Nil(t, decoded["Secret"])
+
+	var m NewRecordModel
+	require.NoError(t, m.SetPayload(decoded, dm))
+	assert.Equal(t, EncodeBytes(b), m.PayloadBytes)
+}
+
+func TestMarshalPayloadErrors(t *testing.T) {
+	dm := testDataModel()
+	dm.Version = 2
+	valid := func() map[string]any {
+		return map[string]any{"Version": 1, "Name": "x", "Kind": 0, "Flags": 0,
+			"CreatedAt": nil, "Secret": nil}
+	}
+	check := func(v any, path string, message string) {
+		t.Helper()
+		_, err := MarshalPayload(v, dm)
+		var fieldErr *PayloadFieldError
+		require.True(t, errors.As(err, &fieldErr), "%v", err)
+		assert.ErrorIs(t, err, ErrPayloadMismatch)
+		assert.Equal(t, path, fieldErr.Path)
+		assert.Equal(t, message, fieldErr.Message)
+	}
+
+	_, err := MarshalPayload(valid(), dm)
+	require.NoError(t, err)
+
+	v := valid()
+	v["Items"] = []any{}
+	check(v, "Items", "the field was introduced in version 2 but the payload has version 1")
+
+	v = valid()
+	v["Version"] = 3
+	check(v, "Version", "version 3 is not supported by the data model version 2")
+
+	v = valid()
+	delete(v, "Name")
+	check(v, "Name", "missing value")
+
+	v = valid()
+	v["Other"] = 1
+	check(v, "Other", "the field is not in the data model")
+
+	v = valid()
+	v["Name"] = 1
+	check(v, "Name", "cannot encode int as tag 17")
+
+	v = valid()
+	v["Kind"] = "Unknown"
+	check(v, "Kind", `unknown enumeration value "Unknown"`)
+
+	v = valid()
+	v["Kind"] = -1
+	check(v, "Kind", "the value -1 does not fit in tag 10")
+
+	v = valid()
+	v["Version"] = 2
+	v["Items"] = []any{map[string]any{"Id": 1, "Label": 2}}
+	check(v, "Items[0].Label", "cannot encode int as tag 17")
+
+	type wrongTag struct {
+		Version uint64 `iltag:"tagId=8"`
+	}
+	check(wrongTag{}, "Version", "the iltag struct tag declares tag 8 but the data model expects tag 10")
+
+	type badTag struct {
+		Version uint64 `iltag:"id=10"`
+	}
+	check(badTag{}, "Version", `unknown option "id=10" in the iltag struct tag`)
+
+	payload, err := MarshalPayload(valid(), dm)
+	require.NoError(t, err)
+	var wrong wrongTag
+	err = UnmarshalPayload(payload, dm, &wrong)
+	assert.ErrorIs(t, err, ErrPayloadMismatch)
+	assert.EqualError(t, err, "payload field Version: the iltag struct tag declares tag 8 but the data model expects tag 10")
+
+	check(1, "", "cannot encode int as a compound value")
+}
+
+func TestEncodeStandardTag(t *testing.T) {
+	encode := func(id tags.TagID, v any) tags.ILTag {
+		t.Helper()
+		tag, err := encodePayloadField(&DataField{TagId: int64(id)}, reflect.ValueOf(v), "")
+		require.NoError(t, err)
+		return tag
+	}
+
+	assert.Equal(t, int8(-1), encode(tags.IL_INT8_TAG_ID, -1).(*impl.Int8Tag).Payload)
+	assert.Equal(t, uint16(65535), encode(tags.IL_UINT16_TAG_ID, uint64(65535)).(*impl.UInt16Tag).Payload)
+	assert.Equal(t, float32(1.5), encode(tags.IL_BIN32_TAG_ID, 1.5).(*impl.Float32Tag).Payload)
+	assert.Equal(t, []uint64{1, 2}, encode(tags.IL_OID_TAG_ID, []int{1, 2}).(*impl.ILIntArrayTag).Payload)
+	assert.Equal(t, tags.IL_NULL_TAG_ID, encode(tags.IL_STRING_TAG_ID, (*string)(nil)).Id())
+	assert.Equal(t, tags.IL_NULL_TAG_ID, encode(tags.IL_BDEC_TAG_ID, "").Id())
+	assert.Equal(t, tags.IL_NULL_TAG_ID, encode(tags.IL_VERSION_TAG_ID, "").Id())
+
+	_, err := encodePayloadField(&DataField{TagId: int64(tags.IL_UINT8_TAG_ID)}, reflect.ValueOf(256), "")
+	assert.ErrorIs(t, err, ErrPayloadMismatch)
+
+	for _, n := range []*big.Int{big.NewInt(0), big.NewInt(-1), big.NewInt(127), big.NewInt(128),
+		big.NewInt(-128), big.NewInt(-129), big.NewInt(-256), new(big.Int).Lsh(big.NewInt(-3), 100)} {
+		assert.Zero(t, n.Cmp(bigIntFromBytes(bigIntToBytes(n))), n.String())
+		v, err := standardTagValue(encode(tags.IL_BINT_TAG_ID, n))
+		require.NoError(t, err)
+		assert.Zero(t, n.Cmp(v.(*big.Int)), n.String())
+	}
+
+	for _, s := range []string{"0", "-0.05", "12.34", "-1234"} {
+		v, err := standardTagValue(encode(tags.IL_BDEC_TAG_ID, s))
+		require.NoError(t, err)
+		assert.Equal(t, s, v)
+	}
+
+	for _, c := range []struct {
+		id tags.TagID
+		v  any
+	}{
+		{tags.IL_VERSION_TAG_ID, "1.2.3.4"},
+		{tags.IL_RANGE_TAG_ID, map[string]any{"start": uint64(1), "count": uint16(2)}},
+		{tags.IL_STRING_DICTIONARY_TAG_ID, map[string]string{"a": "1", "b": "2"}},
+		{tags.IL_DICTIONARY_TAG_ID, map[string]any{"a": "1", "b": true}},
+	} {
+		v, err := standardTagValue(encode(c.id, c.v))
+		require.NoError(t, err)
+		assert.Equal(t, c.v, v)
+	}
+}
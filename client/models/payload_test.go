@@ -0,0 +1,272 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/interlockledger/go-iltags/tags"
+	"github.com/interlockledger/go-iltags/tags/impl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Serializes the tags as the payload of a compound tag.
+func compoundTag(t *testing.T, id tags.TagID, fields ...tags.ILTag) []byte {
+	var payload []byte
+	for _, f := range fields {
+		b, err := tags.ILTagToBytes(f)
+		require.NoError(t, err)
+		payload = append(payload, b...)
+	}
+	raw := tags.NewRawTag(id)
+	raw.Payload = payload
+	b, err := tags.ILTagToBytes(raw)
+	require.NoError(t, err)
+	return b
+}
+
+func ilint(v uint64) tags.ILTag {
+	t := impl.NewStdILIntTag()
+	t.Payload = v
+	return t
+}
+
+func str(v string) tags.ILTag {
+	t := impl.NewStdStringTag()
+	t.Payload = v
+	return t
+}
+
+func testDataModel() *DataModel {
+	dateTime := DATE_TIME_CastType
+	return &DataModel{
+		PayloadTagId: 1000,
+		DataFields: []DataField{
+			{Name: "Version", TagId: int64(tags.IL_ILINT_TAG_ID), Version: 1},
+			{Name: "Name", TagId: int64(tags.IL_STRING_TAG_ID), Version: 1},
+			{Name: "Kind", TagId: int64(tags.IL_ILINT_TAG_ID), Version: 1,
+				Enumeration: &EnumerationItems{Items: map[uint64]EnumerationItem{
+					0: {Name: "None"}, 1: {Name: "Simple"}, 2: {Name: "Complex"}}}},
+			{Name: "Flags", TagId: int64(tags.IL_ILINT_TAG_ID), Version: 1, EnumerationAsFlags: true,
+				Enumeration: &EnumerationItems{Items: map[uint64]EnumerationItem{
+					0: {Name: "None"}, 1: {Name: "Read"}, 2: {Name: "Write"}, 4: {Name: "Exec"}}}},
+			{Name: "CreatedAt", TagId: int64(tags.IL_INT64_TAG_ID), Version: 1, Cast: &dateTime},
+			{Name: "Secret", TagId: 1001, Version: 1, IsOpaque: true},
+			{Name: "Items", TagId: int64(tags.IL_ILTAGARRAY_TAG_ID), ElementTagId: 1002, Version: 2,
+				SubDataFields: []DataField{
+					{Name: "Id", TagId: int64(tags.IL_ILINT_TAG_ID)},
+					{Name: "Label", TagId: int64(tags.IL_STRING_TAG_ID)},
+				}},
+		},
+	}
+}
+
+func testPayload(t *testing.T, version uint64, name string) []byte {
+	createdAt := impl.NewStdInt64Tag()
+	createdAt.Payload = dotNetUnixEpochTicks + 1600000000*10000000
+	secret := tags.NewRawTag(1001)
+	secret.Payload = []byte{1, 2, 3}
+	item := func(id uint64, label string) tags.ILTag {
+		b := compoundTag(t, 1002, ilint(id), str(label))
+		tag, err := DecodePayload(b)
+		require.NoError(t, err)
+		return tag
+	}
+	items := impl.NewStdILTagArrayTag()
+	items.Payload = []tags.ILTag{item(1, "a"), item(2, "b")}
+	return compoundTag(t, 1000, ilint(version), str(name), ilint(2), ilint(5),
+		createdAt, secret, items)
+}
+
+func TestDecodePayload(t *testing.T) {
+	tag, err := DecodePayload([]byte{0xF8, 0x34, 0x00})
+	require.NoError(t, err)
+	assert.Equal(t, tags.TagID(300), tag.Id())
+	assert.IsType(t, &tags.RawTag{}, tag)
+
+	m := RecordModel{PayloadBytes: EncodeBytes([]byte{0x0A, 0x05})}
+	tag, err = m.DecodePayload()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), tag.(*impl.ILIntTag).Payload)
+
+	_, err = DecodePayload([]byte{0x11, 0x05})
+	assert.Error(t, err)
+}
+
+func TestDecodePayloadWith(t *testing.T) {
+	dm := testDataModel()
+
+	v, err := DecodePayloadWith(testPayload(t, 2, "test"), dm)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"Version":   uint64(2),
+		"Name":      "test",
+		"Kind":      "Complex",
+		"Flags":     "Read|Exec",
+		"CreatedAt": time.Unix(1600000000, 0).UTC(),
+		"Secret":    []byte{1, 2, 3},
+		"Items": []any{
+			map[string]any{"Id": uint64(1), "Label": "a"},
+			map[string]any{"Id": uint64(2), "Label": "b"},
+		},
+	}, v)
+
+	// Version 1 does not have the items.
+	v, err = DecodePayloadWith(compoundTag(t, 1000, ilint(1), str("old")), dm)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"Version": uint64(1), "Name": "old"}, v)
+
+	// Unknown enumeration values
+	v, err = DecodePayloadWith(compoundTag(t, 1000, ilint(1), str("x"), ilint(7), ilint(9)), dm)
+	require.NoError(t, err)
+	assert.Equal(t, "7", v["Kind"])
+	assert.Equal(t, "Read|8", v["Flags"])
+
+	// Wrong payload tag
+	_, err = DecodePayloadWith(compoundTag(t, 1001, ilint(1)), dm)
+	assert.ErrorIs(t, err, ErrPayloadMismatch)
+
+	// Wrong field tag
+	_, err = DecodePayloadWith(compoundTag(t, 1000, ilint(1), ilint(1)), dm)
+	var fieldErr *PayloadFieldError
+	require.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, "Name", fieldErr.Path)
+	assert.ErrorIs(t, err, ErrPayloadMismatch)
+	assert.Equal(t, "payload field Name: expected tag 17 but found 10", err.Error())
+
+	// Wrong array element
+	items := impl.NewStdILTagArrayTag()
+	items.Payload = []tags.ILTag{str("bad")}
+	_, err = DecodePayloadWith(compoundTag(t, 1000, ilint(2), str("x"), ilint(0), ilint(0),
+		impl.NewStdInt64Tag(), tags.NewRawTag(1001), items), dm)
+	require.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, "Items[0]", fieldErr.Path)
+}
+
+func TestUnmarshalPayload(t *testing.T) {
+	type item struct {
+		Id    uint64
+		Label string
+	}
+	var v struct {
+		Version   int
+		Name      string
+		Kind      string
+		CreatedAt time.Time
+		Items     []item
+	}
+	m := RecordModel{PayloadBytes: EncodeBytes(testPayload(t, 2, "test"))}
+	require.NoError(t, m.UnmarshalPayload(testDataModel(), &v))
+	assert.Equal(t, 2, v.Version)
+	assert.Equal(t, "test", v.Name)
+	assert.Equal(t, "Complex", v.Kind)
+	assert.True(t, time.Unix(1600000000, 0).Equal(v.CreatedAt))
+	assert.Equal(t, []item{{1, "a"}, {2, "b"}}, v.Items)
+
+	// Enumerations are stored as numbers into integer fields.
+	var p struct {
+		Title string `iltag:"name=Name"`
+		Kind  *int
+		Flags uint64
+	}
+	require.NoError(t, UnmarshalPayload(testPayload(t, 2, "test"), testDataModel(), &p))
+	assert.Equal(t, "test", p.Title)
+	require.NotNil(t, p.Kind)
+	assert.Equal(t, 2, *p.Kind)
+	assert.Equal(t, uint64(5), p.Flags)
+
+	var small struct{ Kind int8 }
+	require.NoError(t, UnmarshalPayload(testPayload(t, 2, "test"), testDataModel(), &small))
+	assert.Equal(t, int8(2), small.Kind)
+	var overflow struct{ Kind int8 }
+	err := UnmarshalPayload(compoundTag(t, 1000, ilint(1), str("x"), ilint(300)), testDataModel(), &overflow)
+	assert.ErrorIs(t, err, ErrPayloadMismatch)
+	assert.EqualError(t, err, "payload field Kind: the value 300 does not fit in int8")
+
+	// Maps and interfaces receive the values of DecodePayloadWith().
+	expected, err := DecodePayloadWith(testPayload(t, 2, "test"), testDataModel())
+	require.NoError(t, err)
+	var m2 map[string]any
+	require.NoError(t, UnmarshalPayload(testPayload(t, 2, "test"), testDataModel(), &m2))
+	assert.Equal(t, expected, m2)
+	var a any
+	require.NoError(t, UnmarshalPayload(testPayload(t, 2, "test"), testDataModel(), &a))
+	assert.Equal(t, expected, a)
+
+	var wrong struct{ Name int }
+	err = UnmarshalPayload(testPayload(t, 2, "test"), testDataModel(), &wrong)
+	assert.EqualError(t, err, "payload field Name: cannot decode string into int")
+	assert.Error(t, UnmarshalPayload(testPayload(t, 2, "test"), testDataModel(), wrong))
+}
+
+func TestStandardTagValue(t *testing.T) {
+	bi := impl.NewStdBigIntTag()
+	bi.Payload = []byte{0xFF, 0x00}
+	v, err := standardTagValue(bi)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(-256), v)
+
+	bd := impl.NewStdBigDecTag()
+	bd.Payload = []byte{0xFB}
+	bd.Scale = 2
+	v, err = standardTagValue(bd)
+	require.NoError(t, err)
+	assert.Equal(t, "-0.05", v)
+
+	ver := impl.NewStdVersionTag()
+	ver.Major, ver.Minor, ver.Revision, ver.Build = 1, 2, 3, 4
+	v, err = standardTagValue(ver)
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.3.4", v)
+}
+
+func TestAppsModelFindDataModel(t *testing.T) {
+	var m AppsModel
+	require.NoError(t, json.Unmarshal([]byte(`{"validApps":[{"id":8,"dataModels":[
+		{"payloadTagId":1000,"dataFields":[{"name":"Kind","tagId":10,
+			"enumeration":{"1":{"name":"One"},"2":{"name":"Two","description":"2"},"isInvalid":false}}]}]}]}`), &m))
+
+	assert.Nil(t, m.FindDataModel(7, 1000))
+	assert.Nil(t, m.FindDataModel(8, 1001))
+	dm := m.FindDataModel(8, 1000)
+	require.NotNil(t, dm)
+	assert.Equal(t, map[uint64]EnumerationItem{1: {Name: "One"}, 2: {Name: "Two", Description: "2"}},
+		dm.DataFields[0].Enumeration.Items)
+
+	b, err := json.Marshal(dm.DataFields[0].Enumeration)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"1":{"name":"One"},"2":{"name":"Two","description":"2"}}`, string(b))
+}
@@ -0,0 +1,163 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+/*
+The universal record reference is invalid.
+*/
+var ErrInvalidRecordReference = errors.New("invalid record reference")
+
+/*
+A universal record reference in the form networkName:chainId@recordSerial, as
+found in RecordModel.Reference. It can be used as a value and it is encoded as
+a string by both encoding/json and the other encoders that use
+encoding.TextMarshaler. The zero value is encoded as an empty string.
+
+It is not the same as RecordReference, which is the structure used by the
+node to describe references inside other models.
+*/
+type UniversalRecordReference struct {
+	// Name of the network.
+	Network string
+	// Chain unique ID.
+	Chain string
+	// Serial of the record.
+	Serial int64
+}
+
+/*
+Parses a universal record reference in the form networkName:chainId@recordSerial.
+It returns an error that wraps ErrInvalidRecordReference if s is not a valid
+reference.
+*/
+func ParseUniversalRecordReference(s string) (UniversalRecordReference, error) {
+	network, rest, ok := strings.Cut(s, ":")
+	if !ok {
+		return UniversalRecordReference{}, fmt.Errorf("%w %q: the network is missing", ErrInvalidRecordReference, s)
+	}
+	chain, serial, ok := strings.Cut(rest, "@")
+	if !ok {
+		return UniversalRecordReference{}, fmt.Errorf("%w %q: the serial is missing", ErrInvalidRecordReference, s)
+	}
+	n, err := strconv.ParseInt(serial, 10, 64)
+	if err != nil {
+		return UniversalRecordReference{}, fmt.Errorf("%w %q: invalid serial %q", ErrInvalidRecordReference, s, serial)
+	}
+	ret := UniversalRecordReference{Network: network, Chain: chain, Serial: n}
+	if err := ret.Validate(); err != nil {
+		return UniversalRecordReference{}, err
+	}
+	return ret, nil
+}
+
+/*
+Checks if the reference is valid. The network must not be empty nor contain
+spaces, ':' or '@', the chain must be a non empty base64url string and the
+serial must not be negative. It returns an error that wraps
+ErrInvalidRecordReference otherwise.
+*/
+func (r UniversalRecordReference) Validate() error {
+	if r.Network == "" || strings.IndexFunc(r.Network, func(c rune) bool {
+		return c == ':' || c == '@' || unicode.IsSpace(c) || unicode.IsControl(c)
+	}) >= 0 {
+		return fmt.Errorf("%w: invalid network %q", ErrInvalidRecordReference, r.Network)
+	}
+	if r.Chain == "" || strings.IndexFunc(r.Chain, func(c rune) bool {
+		return !(c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c == '_')
+	}) >= 0 {
+		return fmt.Errorf("%w: invalid chain %q", ErrInvalidRecordReference, r.Chain)
+	}
+	if r.Serial < 0 {
+		return fmt.Errorf("%w: invalid serial %d", ErrInvalidRecordReference, r.Serial)
+	}
+	return nil
+}
+
+/*
+Returns true if this is the zero value.
+*/
+func (r UniversalRecordReference) IsZero() bool {
+	return r == UniversalRecordReference{}
+}
+
+/*
+Returns the reference in the form networkName:chainId@recordSerial.
+*/
+func (r UniversalRecordReference) String() string {
+	return r.Network + ":" + r.Chain + "@" + strconv.FormatInt(r.Serial, 10)
+}
+
+// Implements encoding.TextMarshaler.
+func (r UniversalRecordReference) MarshalText() ([]byte, error) {
+	if r.IsZero() {
+		return []byte{}, nil
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(r.String()), nil
+}
+
+// Implements encoding.TextUnmarshaler.
+func (r *UniversalRecordReference) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*r = UniversalRecordReference{}
+		return nil
+	}
+	v, err := ParseUniversalRecordReference(string(text))
+	if err != nil {
+		return err
+	}
+	*r = v
+	return nil
+}
+
+/*
+Parses the universal reference of the record.
+*/
+func (m *RecordModel) UniversalReference() (UniversalRecordReference, error) {
+	return ParseUniversalRecordReference(m.Reference)
+}
+
+/*
+Parses the universal reference of the record.
+*/
+func (m *RecordModelAsJson) UniversalReference() (UniversalRecordReference, error) {
+	return ParseUniversalRecordReference(m.Reference)
+}
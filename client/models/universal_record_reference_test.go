@@ -0,0 +1,98 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUniversalRecordReference(t *testing.T) {
+	ref, err := ParseUniversalRecordReference("Minerva:Ab_c-1@12")
+	require.NoError(t, err)
+	assert.Equal(t, UniversalRecordReference{Network: "Minerva", Chain: "Ab_c-1", Serial: 12}, ref)
+	assert.Equal(t, "Minerva:Ab_c-1@12", ref.String())
+
+	for _, s := range []string{
+		"",
+		"Minerva",
+		"Minerva:chain",
+		":chain@1",
+		"Minerva:@1",
+		"Minerva:chain@",
+		"Minerva:chain@x",
+		"Minerva:chain@-1",
+		"Minerva:ch:ain@1",
+		"Minerva:ch=ain@1",
+		"Min erva:chain@1",
+		"Minerva:chain@1@2",
+	} {
+		_, err := ParseUniversalRecordReference(s)
+		assert.ErrorIs(t, err, ErrInvalidRecordReference, s)
+	}
+}
+
+func TestUniversalRecordReferenceJSON(t *testing.T) {
+	type holder struct {
+		Ref  UniversalRecordReference  `json:"ref"`
+		Next *UniversalRecordReference `json:"next,omitempty"`
+	}
+	h := holder{Ref: UniversalRecordReference{Network: "net", Chain: "chain", Serial: 3}}
+	b, err := json.Marshal(h)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"ref":"net:chain@3"}`, string(b))
+
+	var decoded holder
+	require.NoError(t, json.Unmarshal([]byte(`{"ref":"net:chain@3","next":"net:chain@4"}`), &decoded))
+	assert.Equal(t, h.Ref, decoded.Ref)
+	assert.Equal(t, int64(4), decoded.Next.Serial)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"ref":""}`), &decoded))
+	assert.True(t, decoded.Ref.IsZero())
+	b, err = json.Marshal(holder{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"ref":""}`, string(b))
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"ref":"bad"}`), &decoded), ErrInvalidRecordReference)
+	_, err = json.Marshal(holder{Ref: UniversalRecordReference{Chain: "chain"}})
+	assert.ErrorIs(t, err, ErrInvalidRecordReference)
+
+	m := RecordModel{Reference: "net:chain@5"}
+	ref, err := m.UniversalReference()
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), ref.Serial)
+	mj := RecordModelAsJson{Reference: "bad"}
+	_, err = mj.UniversalReference()
+	assert.ErrorIs(t, err, ErrInvalidRecordReference)
+}
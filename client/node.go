@@ -91,7 +91,7 @@ func (a *NodeApiService) ApiVersion(ctx context.Context) (string, *http.Response
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("ApiVersion", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -111,32 +111,7 @@ func (a *NodeApiService) ApiVersion(ctx context.Context) (string, *http.Response
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v string
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("ApiVersion", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -182,7 +157,7 @@ func (a *NodeApiService) AppsList(ctx context.Context) (models.AppsModel, *http.
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Apps_List", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -202,32 +177,7 @@ func (a *NodeApiService) AppsList(ctx context.Context) (models.AppsModel, *http.
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v models.AppsModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Apps_List", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -296,7 +246,7 @@ func (a *NodeApiService) InterlockingsList(ctx context.Context, targetChain stri
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Interlockings_List", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -316,32 +266,7 @@ func (a *NodeApiService) InterlockingsList(ctx context.Context, targetChain stri
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v models.InterlockingRecordModelPageOf
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Interlockings_List", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -386,7 +311,7 @@ func (a *NodeApiService) MirrorAdd(ctx context.Context, chains []string) ([]mode
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Mirror_Add", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -406,32 +331,7 @@ func (a *NodeApiService) MirrorAdd(ctx context.Context, chains []string) ([]mode
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v []models.ChainIdModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Mirror_Add", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -477,7 +377,7 @@ func (a *NodeApiService) MirrorsList(ctx context.Context) ([]models.ChainIdModel
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Mirrors_List", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -497,32 +397,7 @@ func (a *NodeApiService) MirrorsList(ctx context.Context) ([]models.ChainIdModel
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v []models.ChainIdModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Mirrors_List", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -568,7 +443,7 @@ func (a *NodeApiService) NodeDetails(ctx context.Context) (models.NodeDetailsMod
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Node_Details", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -588,32 +463,7 @@ func (a *NodeApiService) NodeDetails(ctx context.Context) (models.NodeDetailsMod
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v models.NodeDetailsModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Node_Details", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -659,7 +509,7 @@ func (a *NodeApiService) PeersList(ctx context.Context) ([]models.PeerModel, *ht
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Peers_List", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -679,32 +529,7 @@ func (a *NodeApiService) PeersList(ctx context.Context) ([]models.PeerModel, *ht
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 200:
-			var v []models.PeerModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Peers_List", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -89,7 +89,7 @@ func (a *OpaqueService) Create(ctx context.Context,
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Opaque_Create", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -109,35 +109,10 @@ func (a *OpaqueService) Create(ctx context.Context,
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 201:
-			var v models.ChainCreatedModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 409:
-			// Conflict
-			return localVarReturnValue, localVarHttpResponse, ErrOptimisticLockError
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		apiErr := newAPIError("Opaque_Create", localVarHttpResponse, localVarBody)
+		// Conflicts are caused by an outdated lastChangedRecordSerial.
+		apiErr.optimisticLock = localVarHttpResponse.StatusCode == 409
+		return localVarReturnValue, localVarHttpResponse, apiErr
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -187,7 +162,7 @@ func (a *OpaqueService) Get(ctx context.Context,
 		return nil, 0, 0, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callCachedAPI("Opaque_Get", chain, serial, r)
 	if err != nil || localVarHttpResponse == nil {
 		return nil, 0, 0, localVarHttpResponse, err
 	}
@@ -216,32 +191,7 @@ func (a *OpaqueService) Get(ctx context.Context,
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 201:
-			var v models.ChainCreatedModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return nil, 0, 0, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return nil, 0, 0, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return nil, 0, 0, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return nil, 0, 0, localVarHttpResponse, newErr
-		default:
-			return nil, 0, 0, localVarHttpResponse, newErr
-		}
+		return nil, 0, 0, localVarHttpResponse, newAPIError("Opaque_Get", localVarHttpResponse, localVarBody)
 	}
 	return nil, 0, 0, localVarHttpResponse, nil
 }
@@ -305,7 +255,7 @@ func (a *OpaqueService) Query(ctx context.Context,
 		return nil, 0, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Opaque_Query", r)
 	if err != nil || localVarHttpResponse == nil {
 		return nil, 0, localVarHttpResponse, err
 	}
@@ -324,32 +274,7 @@ func (a *OpaqueService) Query(ctx context.Context,
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 201:
-			var v models.ChainCreatedModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return nil, 0, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return nil, 0, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return nil, 0, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return nil, 0, localVarHttpResponse, newErr
-		default:
-			return nil, 0, localVarHttpResponse, newErr
-		}
+		return nil, 0, localVarHttpResponse, newAPIError("Opaque_Query", localVarHttpResponse, localVarBody)
 	}
 	return nil, lastChangedRecordSerial, localVarHttpResponse, nil
 }
@@ -416,7 +341,7 @@ func (a *OpaqueService) QueryJson(ctx context.Context,
 		return localVarReturnValue, nil, err
 	}
 
-	localVarHttpResponse, err := a.client.callAPI(r)
+	localVarHttpResponse, err := a.client.callAPI("Opaque_Query_AsJson", r)
 	if err != nil || localVarHttpResponse == nil {
 		return localVarReturnValue, localVarHttpResponse, err
 	}
@@ -438,32 +363,7 @@ func (a *OpaqueService) QueryJson(ctx context.Context,
 	}
 
 	if localVarHttpResponse.StatusCode >= 300 {
-		newErr := GenericSwaggerError{
-			body:  localVarBody,
-			error: localVarHttpResponse.Status,
-		}
-		switch localVarHttpResponse.StatusCode {
-		case 201:
-			var v models.ChainCreatedModel
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		case 400, 401, 403, 404, 422:
-			var v map[string]models.Object
-			err = a.client.decode(&v, localVarBody, localVarHttpResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHttpResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHttpResponse, newErr
-		default:
-			return localVarReturnValue, localVarHttpResponse, newErr
-		}
+		return localVarReturnValue, localVarHttpResponse, newAPIError("Opaque_Query_AsJson", localVarHttpResponse, localVarBody)
 	}
 	return localVarReturnValue, localVarHttpResponse, nil
 }
@@ -0,0 +1,266 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"context"
+	"iter"
+
+	"github.com/antihax/optional"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+)
+
+/*
+Function used by Pager to fetch a page. It must return the items of the given
+page and the total number of pages reported by the node.
+*/
+type PageFetcher[T any] func(ctx context.Context, page int32) ([]T, int32, error)
+
+/*
+Walks through all items of a paged endpoint, fetching one page at a time. The
+page size, direction and filters are the ones passed to the constructor.
+
+Pager can be used as a cursor:
+
+	p := client.NewRecordsListPager(ctx, c.RecordApi, chain, nil)
+	for p.Next() {
+		rec := p.Item()
+		...
+	}
+	if err := p.Err(); err != nil {
+		...
+	}
+
+or as an iterator:
+
+	for rec, err := range p.All() {
+		if err != nil {
+			...
+		}
+		...
+	}
+
+It stops as soon as the context is canceled or a page cannot be fetched. A
+Pager is not safe for concurrent use.
+*/
+type Pager[T any] struct {
+	ctx   context.Context
+	fetch PageFetcher[T]
+	page  int32
+	last  bool
+	items []T
+	index int
+	item  T
+	err   error
+}
+
+/*
+Creates a new Pager that starts at firstPage. Pages are numbered from 0.
+*/
+func NewPager[T any](ctx context.Context, firstPage int32, fetch PageFetcher[T]) *Pager[T] {
+	return &Pager[T]{
+		ctx:   ctx,
+		fetch: fetch,
+		page:  firstPage,
+	}
+}
+
+/*
+Advances to the next item, fetching the next page if required. It returns
+false when there are no more items or if an error occurred. Use Err() to
+tell both cases apart.
+*/
+func (p *Pager[T]) Next() bool {
+	if p.err != nil {
+		return false
+	}
+	if err := p.ctx.Err(); err != nil {
+		p.err = err
+		return false
+	}
+	for p.index >= len(p.items) {
+		if p.last || !p.fetchNext() {
+			return false
+		}
+	}
+	p.item = p.items[p.index]
+	p.index++
+	return true
+}
+
+// Fetches the next page.
+func (p *Pager[T]) fetchNext() bool {
+	items, total, err := p.fetch(p.ctx, p.page)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	p.items = items
+	p.index = 0
+	p.page++
+	p.last = len(items) == 0 || p.page >= total
+	return true
+}
+
+/*
+Returns the current item.
+*/
+func (p *Pager[T]) Item() T {
+	return p.item
+}
+
+/*
+Returns the error that stopped the pager, if any.
+*/
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+/*
+Returns an iterator over the remaining items. If an error occurs, it is
+yielded with the zero value of T as the last pair.
+*/
+func (p *Pager[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p.Next() {
+			if !yield(p.item, nil) {
+				return
+			}
+		}
+		if p.err != nil {
+			var zero T
+			yield(zero, p.err)
+		}
+	}
+}
+
+/*
+Creates a Pager over RecordApi.RecordsList(). The page size, direction, serial
+range and first page are taken from options, which may be nil.
+*/
+func NewRecordsListPager(ctx context.Context, api RecordAPI, chain string,
+	options *RecordApiRecordsListOpts) *Pager[models.RecordModel] {
+	var opts RecordApiRecordsListOpts
+	if options != nil {
+		opts = *options
+	}
+	return NewPager(ctx, opts.Page.Value(), func(ctx context.Context, page int32) ([]models.RecordModel, int32, error) {
+		opts.Page = optional.NewInt32(page)
+		ret, _, err := api.RecordsList(ctx, chain, &opts)
+		return ret.Items, ret.TotalNumberOfPages, err
+	})
+}
+
+/*
+Creates a Pager over RecordApi.RecordsListAsJson(). The page size, direction,
+serial range and first page are taken from options, which may be nil.
+*/
+func NewRecordsListAsJsonPager(ctx context.Context, api RecordAPI, chain string,
+	options *RecordApiRecordsListAsJsonOpts) *Pager[models.RecordModelAsJson] {
+	var opts RecordApiRecordsListAsJsonOpts
+	if options != nil {
+		opts = *options
+	}
+	return NewPager(ctx, opts.Page.Value(), func(ctx context.Context, page int32) ([]models.RecordModelAsJson, int32, error) {
+		opts.Page = optional.NewInt32(page)
+		ret, _, err := api.RecordsListAsJson(ctx, chain, &opts)
+		return ret.Items, ret.TotalNumberOfPages, err
+	})
+}
+
+/*
+Creates a Pager over RecordApi.RecordsQuery(). The page size, direction, query
+and first page are taken from options, which may be nil.
+*/
+func NewRecordsQueryPager(ctx context.Context, api RecordAPI, chain string,
+	options *RecordApiRecordsQueryOpts) *Pager[models.RecordModel] {
+	var opts RecordApiRecordsQueryOpts
+	if options != nil {
+		opts = *options
+	}
+	return NewPager(ctx, opts.Page.Value(), func(ctx context.Context, page int32) ([]models.RecordModel, int32, error) {
+		opts.Page = optional.NewInt32(page)
+		ret, _, err := api.RecordsQuery(ctx, chain, &opts)
+		return ret.Items, ret.TotalNumberOfPages, err
+	})
+}
+
+/*
+Creates a Pager over RecordApi.RecordsQueryAsJson(). The page size, direction,
+query and first page are taken from options, which may be nil.
+*/
+func NewRecordsQueryAsJsonPager(ctx context.Context, api RecordAPI, chain string,
+	options *RecordApiRecordsQueryAsJsonOpts) *Pager[models.RecordModelAsJson] {
+	var opts RecordApiRecordsQueryAsJsonOpts
+	if options != nil {
+		opts = *options
+	}
+	return NewPager(ctx, opts.Page.Value(), func(ctx context.Context, page int32) ([]models.RecordModelAsJson, int32, error) {
+		opts.Page = optional.NewInt32(page)
+		ret, _, err := api.RecordsQueryAsJson(ctx, chain, &opts)
+		return ret.Items, ret.TotalNumberOfPages, err
+	})
+}
+
+/*
+Creates a Pager over ChainApi.ChainInterlockingsList(). The page size,
+HowManyFromLast and first page are taken from options, which may be nil. The
+direction cannot be chosen for this endpoint.
+*/
+func NewChainInterlockingsPager(ctx context.Context, api ChainAPI, chain string,
+	options *ChainApiChainInterlockingsListOpts) *Pager[models.InterlockingRecordModel] {
+	var opts ChainApiChainInterlockingsListOpts
+	if options != nil {
+		opts = *options
+	}
+	return NewPager(ctx, opts.Page.Value(), func(ctx context.Context, page int32) ([]models.InterlockingRecordModel, int32, error) {
+		opts.Page = optional.NewInt32(page)
+		ret, _, err := api.ChainInterlockingsList(ctx, chain, &opts)
+		return ret.Items, ret.TotalNumberOfPages, err
+	})
+}
+
+/*
+Creates a Pager over NodeApi.InterlockingsList(). The page size, direction,
+LastKnownBlock and first page are taken from options, which may be nil.
+*/
+func NewInterlockingsPager(ctx context.Context, api NodeAPI, targetChain string,
+	options *NodeApiInterlockingsListOpts) *Pager[models.InterlockingRecordModel] {
+	var opts NodeApiInterlockingsListOpts
+	if options != nil {
+		opts = *options
+	}
+	return NewPager(ctx, opts.Page.Value(), func(ctx context.Context, page int32) ([]models.InterlockingRecordModel, int32, error) {
+		opts.Page = optional.NewInt32(page)
+		ret, _, err := api.InterlockingsList(ctx, targetChain, &opts)
+		return ret.Items, ret.TotalNumberOfPages, err
+	})
+}
@@ -0,0 +1,240 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/antihax/optional"
+	"github.com/interlockledger/go-interlockledger-rest-client/client"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/clienttest"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serials[T any](t *testing.T, p *client.Pager[T], serial func(T) int64) []int64 {
+	var ret []int64
+	for item, err := range p.All() {
+		require.Nil(t, err)
+		ret = append(ret, serial(item))
+	}
+	return ret
+}
+
+func recordSerial(r models.RecordModel) int64 {
+	return r.Serial
+}
+
+func jsonRecordSerial(r models.RecordModelAsJson) int64 {
+	return r.Serial
+}
+
+func interlockingSerial(r models.InterlockingRecordModel) int64 {
+	return r.Serial
+}
+
+func TestPager(t *testing.T) {
+	ctx := context.Background()
+	fetch := func(ctx context.Context, page int32) ([]int, int32, error) {
+		return []int{int(page) * 2, int(page)*2 + 1}, 3, nil
+	}
+
+	p := client.NewPager(ctx, 0, fetch)
+	var items []int
+	for p.Next() {
+		items = append(items, p.Item())
+	}
+	assert.Nil(t, p.Err())
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5}, items)
+	assert.False(t, p.Next())
+
+	p = client.NewPager(ctx, 1, fetch)
+	items = nil
+	for item, err := range p.All() {
+		require.Nil(t, err)
+		items = append(items, item)
+		if item == 3 {
+			break
+		}
+	}
+	assert.Equal(t, []int{2, 3}, items)
+	// Resumes from where it stopped
+	for item := range p.All() {
+		items = append(items, item)
+	}
+	assert.Equal(t, []int{2, 3, 4, 5}, items)
+
+	// Empty pages stop the pager
+	calls := 0
+	p = client.NewPager(ctx, 0, func(ctx context.Context, page int32) ([]int, int32, error) {
+		calls++
+		return nil, 10, nil
+	})
+	assert.False(t, p.Next())
+	assert.Nil(t, p.Err())
+	assert.Equal(t, 1, calls)
+}
+
+func TestPager_Errors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fail := errors.New("fail")
+	fetch := func(ctx context.Context, page int32) ([]int, int32, error) {
+		if page == 1 {
+			return nil, 0, fail
+		}
+		return []int{int(page)}, 3, nil
+	}
+
+	p := client.NewPager(ctx, 0, fetch)
+	var items []int
+	var errs []error
+	for item, err := range p.All() {
+		items = append(items, item)
+		errs = append(errs, err)
+	}
+	assert.Equal(t, []int{0, 0}, items)
+	assert.Equal(t, []error{nil, fail}, errs)
+	assert.Same(t, fail, p.Err())
+	assert.False(t, p.Next())
+
+	p = client.NewPager(ctx, 0, func(ctx context.Context, page int32) ([]int, int32, error) {
+		return []int{1, 2, 3}, 100, nil
+	})
+	assert.True(t, p.Next())
+	cancel()
+	assert.False(t, p.Next())
+	assert.ErrorIs(t, p.Err(), context.Canceled)
+}
+
+func TestRecordPagers(t *testing.T) {
+	node := clienttest.NewNode(nil)
+	defer node.Close()
+	c := node.NewClient()
+	ctx := context.Background()
+	chain := node.CreateChain("chain")
+	for i := 0; i < 24; i++ {
+		node.AddJSONRecord(chain, 1, 300, map[string]any{"i": i})
+	}
+	all := make([]int64, 25)
+	for i := range all {
+		all[i] = int64(i)
+	}
+	reversed := make([]int64, 25)
+	for i := range reversed {
+		reversed[i] = int64(24 - i)
+	}
+
+	paging := client.RecordApiPagingOpts{PageSize: optional.NewInt32(7)}
+	assert.Equal(t, all, serials(t, client.NewRecordsListPager(ctx, c.RecordApi, chain, nil), recordSerial))
+	assert.Equal(t, all[10:20], serials(t, client.NewRecordsListPager(ctx, c.RecordApi, chain,
+		&client.RecordApiRecordsListOpts{
+			RecordApiPagingOpts: paging,
+			FirstSerial:         optional.NewInt64(10),
+			LastSerial:          optional.NewInt64(19),
+		}), recordSerial))
+	assert.Equal(t, all[7:], serials(t, client.NewRecordsListAsJsonPager(ctx, c.RecordApi, chain,
+		&client.RecordApiRecordsListAsJsonOpts{
+			RecordApiPagingOpts: client.RecordApiPagingOpts{
+				PageSize: optional.NewInt32(7),
+				Page:     optional.NewInt32(1),
+			},
+		}), jsonRecordSerial))
+	assert.Equal(t, reversed, serials(t, client.NewRecordsQueryPager(ctx, c.RecordApi, chain,
+		&client.RecordApiRecordsQueryOpts{
+			RecordApiPagingOpts: client.RecordApiPagingOpts{
+				PageSize:    optional.NewInt32(7),
+				LastToFirst: optional.NewBool(true),
+			},
+		}), recordSerial))
+	assert.Equal(t, all, serials(t, client.NewRecordsQueryAsJsonPager(ctx, c.RecordApi, chain,
+		&client.RecordApiRecordsQueryAsJsonOpts{RecordApiPagingOpts: paging}), jsonRecordSerial))
+	assert.Equal(t, 16, node.Calls("Records_List")+node.Calls("Records_List_AsJson")+
+		node.Calls("Records_Query")+node.Calls("Records_Query_AsJson"))
+
+	// Error in the middle of the stream
+	p := client.NewRecordsListPager(ctx, c.RecordApi, chain, nil)
+	count := 0
+	for p.Next() {
+		count++
+		if count == 1 {
+			node.FailNext("Records_List", http.StatusInternalServerError, 1)
+		}
+	}
+	assert.Equal(t, 10, count)
+	assert.ErrorIs(t, p.Err(), client.ErrServerError)
+}
+
+func TestInterlockingPagers(t *testing.T) {
+	node := clienttest.NewNode(nil)
+	defer node.Close()
+	c := node.NewClient()
+	ctx := context.Background()
+	source := node.CreateChain("source")
+	target := node.CreateChain("target")
+	for i := 0; i < 12; i++ {
+		_, _, err := c.ChainApi.ChainInterlockingAdd(ctx, source, &models.ForceInterlockModel{TargetChain: target})
+		require.Nil(t, err)
+	}
+
+	assert.Len(t, serials(t, client.NewChainInterlockingsPager(ctx, c.ChainApi, source,
+		&client.ChainApiChainInterlockingsListOpts{PageSize: optional.NewInt32(5)}),
+		interlockingSerial), 12)
+	assert.Equal(t, 3, node.Calls("Chain_Interlockings_List"))
+
+	s := serials(t, client.NewInterlockingsPager(ctx, c.NodeApi, target,
+		&client.NodeApiInterlockingsListOpts{
+			PageSize:    optional.NewInt32(5),
+			LastToFirst: optional.NewBool(true),
+		}), interlockingSerial)
+	require.Len(t, s, 12)
+	assert.Equal(t, int64(12), s[0])
+	assert.Equal(t, int64(1), s[11])
+	assert.Equal(t, 3, node.Calls("Interlockings_List"))
+}
+
+func TestPager_Mock(t *testing.T) {
+	m := &clienttest.MockClient{}
+	m.NodeAPI.InterlockingsListFunc = func(ctx context.Context, targetChain string,
+		optionalParams *client.NodeApiInterlockingsListOpts) (models.InterlockingRecordModelPageOf, *http.Response, error) {
+		return models.InterlockingRecordModelPageOf{
+			Items:              []models.InterlockingRecordModel{{Serial: int64(optionalParams.Page.Value())}},
+			TotalNumberOfPages: 2,
+		}, nil, nil
+	}
+	p := client.NewInterlockingsPager(context.Background(), m.Node(), "chain", nil)
+	assert.Equal(t, []int64{0, 1}, serials(t, p, interlockingSerial))
+	assert.Len(t, m.NodeAPI.CallsTo("InterlockingsList"), 2)
+}
@@ -0,0 +1,318 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/interlockledger/go-interlockledger-rest-client/crypto"
+)
+
+var (
+	// The server certificate does not match any of the pinned fingerprints.
+	ErrServerPinMismatch = fmt.Errorf("server certificate does not match the pinned fingerprints")
+)
+
+/*
+Returns the fingerprints of all pinned keys, from both ServerPins and
+PinnedCertFile. It returns nil if no pins are set.
+*/
+func (c *Configuration) serverPins() ([][]byte, error) {
+	var pins [][]byte
+	for _, s := range c.ServerPins {
+		fp, err := crypto.ParseFingerprint(s)
+		if err != nil {
+			return nil, err
+		}
+		pins = append(pins, fp)
+	}
+	if c.PinnedCertFile != "" {
+		certs, err := crypto.LoadCertificate(c.PinnedCertFile)
+		if err != nil {
+			return nil, err
+		}
+		for _, cert := range certs {
+			pins = append(pins, crypto.SPKIFingerprint(cert))
+		}
+	}
+	return pins, nil
+}
+
+/*
+Creates the callback that verifies if the leaf certificate of the server matches
+one of the pins. The other certificates of the chain are not considered as the
+chain itself is not verified.
+*/
+func newPinVerifier(pins [][]byte) func(tls.ConnectionState) error {
+	return func(cs tls.ConnectionState) error {
+		if len(cs.PeerCertificates) == 0 {
+			return ErrServerPinMismatch
+		}
+		fp := crypto.SPKIFingerprint(cs.PeerCertificates[0])
+		for _, pin := range pins {
+			if bytes.Equal(pin, fp) {
+				return nil
+			}
+		}
+		return fmt.Errorf("%w: %s", ErrServerPinMismatch, crypto.FormatFingerprint(fp))
+	}
+}
+
+// Returns the address host:port of the given base path.
+func baseAddress(basePath string) (string, error) {
+	u, err := url.Parse(basePath)
+	if err != nil {
+		return "", err
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("invalid base path %q", basePath)
+	}
+	if u.Port() != "" {
+		return u.Host, nil
+	}
+	if u.Scheme == "http" {
+		return net.JoinHostPort(u.Hostname(), "80"), nil
+	}
+	return net.JoinHostPort(u.Hostname(), "443"), nil
+}
+
+/*
+Connects to the server at the given address (host:port) and returns the SPKI
+fingerprint of its certificate. The certificate is not verified, thus the
+fingerprint must be confirmed by other means before it is trusted.
+
+The connection uses the transport settings of NewConfiguration(), thus the
+proxy is selected from the environment variables. See
+Configuration.TrustOnFirstUse() to use the settings of a configuration.
+*/
+func FetchServerFingerprint(ctx context.Context, address string) (string, error) {
+	return NewConfiguration().fetchServerFingerprint(ctx, address)
+}
+
+/*
+Fetches the fingerprint of the server as FetchServerFingerprint() does, using
+the proxy, timeout and HTTP/2 settings of this configuration.
+*/
+func (c *Configuration) fetchServerFingerprint(ctx context.Context, address string) (string, error) {
+	var mutex sync.Mutex
+	var leaf *x509.Certificate
+	transport, err := c.createTransport(&tls.Config{
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			if len(cs.PeerCertificates) > 0 {
+				leaf = cs.PeerCertificates[0]
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	defer transport.CloseIdleConnections()
+	request, err := http.NewRequestWithContext(ctx, http.MethodHead, "https://"+address+"/", nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := transport.RoundTrip(request)
+	if resp != nil {
+		resp.Body.Close()
+	}
+	// The server may reject the handshake or the request later as no client
+	// certificate was sent but its certificate is already known at that point.
+	mutex.Lock()
+	defer mutex.Unlock()
+	if leaf == nil {
+		if err == nil {
+			err = fmt.Errorf("no server certificate")
+		}
+		return "", err
+	}
+	return crypto.FormatFingerprint(crypto.SPKIFingerprint(leaf)), nil
+}
+
+/*
+KnownNodes is a list of trusted server fingerprints indexed by the node address
+(host:port). It is persisted as a text file where each line has the address
+followed by a fingerprint, separated by spaces. Empty lines and lines starting
+with '#' are ignored.
+*/
+type KnownNodes struct {
+	path  string
+	nodes map[string][]string
+}
+
+/*
+Loads the known nodes file. If the file does not exist, an empty list is
+returned.
+*/
+func LoadKnownNodes(path string) (*KnownNodes, error) {
+	k := &KnownNodes{path: path, nodes: make(map[string][]string)}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return k, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if err := k.parse(f); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+	return k, nil
+}
+
+// Parses the known nodes entries.
+func (k *KnownNodes) parse(r io.Reader) error {
+	s := bufio.NewScanner(r)
+	line := 0
+	for s.Scan() {
+		line++
+		l := strings.TrimSpace(s.Text())
+		if l == "" || strings.HasPrefix(l, "#") {
+			continue
+		}
+		fields := strings.Fields(l)
+		if len(fields) != 2 {
+			return fmt.Errorf("invalid entry at line %d", line)
+		}
+		if _, err := crypto.ParseFingerprint(fields[1]); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		k.nodes[fields[0]] = append(k.nodes[fields[0]], fields[1])
+	}
+	return s.Err()
+}
+
+// Returns the fingerprints trusted for the given address.
+func (k *KnownNodes) Fingerprints(address string) []string {
+	return k.nodes[address]
+}
+
+// Adds a trusted fingerprint for the given address.
+func (k *KnownNodes) Add(address string, fingerprint string) {
+	for _, fp := range k.nodes[address] {
+		if fp == fingerprint {
+			return
+		}
+	}
+	k.nodes[address] = append(k.nodes[address], fingerprint)
+}
+
+// Saves the known nodes into its file.
+func (k *KnownNodes) Save() error {
+	addresses := make([]string, 0, len(k.nodes))
+	for address := range k.nodes {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	var sb strings.Builder
+	for _, address := range addresses {
+		for _, fp := range k.nodes[address] {
+			fmt.Fprintf(&sb, "%s %s\n", address, fp)
+		}
+	}
+	return os.WriteFile(k.path, []byte(sb.String()), 0600)
+}
+
+/*
+Implements the trust-on-first-use of the server certificates. It is applied to
+BasePath and to all Endpoints. If a node is already present in the known nodes
+file, its fingerprints are added to ServerPins. Otherwise, the fingerprint of
+the server is fetched through the proxy and with the timeouts of this
+configuration, printed into w (if not nil), saved into the known nodes file and
+added to ServerPins.
+
+Since ServerPins is shared by all endpoints, each one of them will accept the
+certificates pinned for the others.
+
+It must be called before Init().
+*/
+func (c *Configuration) TrustOnFirstUse(ctx context.Context, knownNodesFile string, w io.Writer) error {
+	known, err := LoadKnownNodes(knownNodesFile)
+	if err != nil {
+		return err
+	}
+	modified := false
+	for _, basePath := range append([]string{c.BasePath}, c.Endpoints...) {
+		address, err := baseAddress(basePath)
+		if err != nil {
+			return err
+		}
+		fps := known.Fingerprints(address)
+		if len(fps) == 0 {
+			fp, err := c.fetchServerFingerprint(ctx, address)
+			if err != nil {
+				return err
+			}
+			if w != nil {
+				fmt.Fprintf(w, "Trusting the node %s with the fingerprint %s\n", address, fp)
+			}
+			known.Add(address, fp)
+			modified = true
+			fps = []string{fp}
+		}
+		c.addServerPins(fps)
+	}
+	if modified {
+		return known.Save()
+	}
+	return nil
+}
+
+// Adds the fingerprints to ServerPins, ignoring the ones already present.
+func (c *Configuration) addServerPins(fps []string) {
+	for _, fp := range fps {
+		found := false
+		for _, pin := range c.ServerPins {
+			if pin == fp {
+				found = true
+				break
+			}
+		}
+		if !found {
+			c.ServerPins = append(c.ServerPins, fp)
+		}
+	}
+}
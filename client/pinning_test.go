@@ -0,0 +1,287 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/interlockledger/go-interlockledger-rest-client/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPinningTestServer(t *testing.T) *httptest.Server {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`"7.2.0"`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newPinningTestConfiguration(server *httptest.Server) *Configuration {
+	c := NewConfiguration()
+	c.BasePath = server.URL
+	c.CertFile = path.Join("..", "samples", "cert.pem")
+	c.KeyFile = path.Join("..", "samples", "key.pem")
+	return c
+}
+
+func TestConfiguration_ServerPins(t *testing.T) {
+	server := newPinningTestServer(t)
+	fp := crypto.FormatFingerprint(crypto.SPKIFingerprint(server.Certificate()))
+
+	// Without pins, the self-signed certificate is rejected
+	c := newPinningTestConfiguration(server)
+	require.Nil(t, c.Init())
+	_, _, err := NewAPIClient(c).NodeApi.ApiVersion(context.Background())
+	assert.Error(t, err)
+
+	// Pinned
+	c = newPinningTestConfiguration(server)
+	c.ServerPins = []string{"00" + strings.Repeat("11", 31), fp}
+	require.Nil(t, c.Init())
+	v, _, err := NewAPIClient(c).NodeApi.ApiVersion(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, "7.2.0", v)
+
+	// Mismatch, even with NoServerVerification
+	c = newPinningTestConfiguration(server)
+	c.NoServerVerification = true
+	c.ServerPins = []string{"00" + strings.Repeat("11", 31)}
+	require.Nil(t, c.Init())
+	_, _, err = NewAPIClient(c).NodeApi.ApiVersion(context.Background())
+	assert.ErrorIs(t, err, ErrServerPinMismatch)
+	assert.ErrorContains(t, err, fp)
+
+	// Invalid pin
+	c = newPinningTestConfiguration(server)
+	c.ServerPins = []string{"x"}
+	assert.ErrorIs(t, c.Init(), crypto.ErrInvalidFingerprint)
+}
+
+func TestConfiguration_PinnedCertFile(t *testing.T) {
+	server := newPinningTestServer(t)
+	file := filepath.Join(t.TempDir(), "server.pem")
+	require.Nil(t, os.WriteFile(file, pem.EncodeToMemory(&pem.Block{
+		Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600))
+
+	c := newPinningTestConfiguration(server)
+	c.PinnedCertFile = file
+	require.Nil(t, c.Init())
+	_, _, err := NewAPIClient(c).NodeApi.ApiVersion(context.Background())
+	assert.Nil(t, err)
+
+	c = newPinningTestConfiguration(server)
+	c.PinnedCertFile = file + ".none"
+	assert.Error(t, c.Init())
+}
+
+func TestNewPinVerifier(t *testing.T) {
+	assert.ErrorIs(t, newPinVerifier(nil)(tls.ConnectionState{}), ErrServerPinMismatch)
+}
+
+func TestBaseAddress(t *testing.T) {
+	a, err := baseAddress("https://node:1234/base")
+	assert.Nil(t, err)
+	assert.Equal(t, "node:1234", a)
+
+	a, err = baseAddress("https://node")
+	assert.Nil(t, err)
+	assert.Equal(t, "node:443", a)
+
+	a, err = baseAddress("http://node")
+	assert.Nil(t, err)
+	assert.Equal(t, "node:80", a)
+
+	_, err = baseAddress("/")
+	assert.Error(t, err)
+}
+
+func TestKnownNodes(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "known_nodes")
+	fp1 := "sha256/" + strings.Repeat("A", 43) + "="
+	fp2 := strings.Repeat("ab", 32)
+
+	k, err := LoadKnownNodes(file)
+	require.Nil(t, err)
+	assert.Nil(t, k.Fingerprints("node:1"))
+
+	k.Add("node:2", fp2)
+	k.Add("node:1", fp1)
+	k.Add("node:1", fp1)
+	k.Add("node:1", fp2)
+	require.Nil(t, k.Save())
+	b, err := os.ReadFile(file)
+	require.Nil(t, err)
+	assert.Equal(t, "node:1 "+fp1+"\nnode:1 "+fp2+"\nnode:2 "+fp2+"\n", string(b))
+
+	require.Nil(t, os.WriteFile(file, append([]byte("# Comment\n\n"), b...), 0600))
+	k, err = LoadKnownNodes(file)
+	require.Nil(t, err)
+	assert.Equal(t, []string{fp1, fp2}, k.Fingerprints("node:1"))
+	assert.Equal(t, []string{fp2}, k.Fingerprints("node:2"))
+
+	require.Nil(t, os.WriteFile(file, []byte("node:1\n"), 0600))
+	_, err = LoadKnownNodes(file)
+	assert.ErrorContains(t, err, "invalid entry at line 1")
+
+	require.Nil(t, os.WriteFile(file, []byte("node:1 x\n"), 0600))
+	_, err = LoadKnownNodes(file)
+	assert.ErrorIs(t, err, crypto.ErrInvalidFingerprint)
+
+	_, err = LoadKnownNodes(dir)
+	assert.Error(t, err)
+}
+
+func TestConfiguration_TrustOnFirstUse(t *testing.T) {
+	server := newPinningTestServer(t)
+	fp := crypto.FormatFingerprint(crypto.SPKIFingerprint(server.Certificate()))
+	file := filepath.Join(t.TempDir(), "known_nodes")
+
+	c := newPinningTestConfiguration(server)
+	var out bytes.Buffer
+	require.Nil(t, c.TrustOnFirstUse(context.Background(), file, &out))
+	assert.Contains(t, out.String(), fp)
+	assert.Equal(t, []string{fp}, c.ServerPins)
+	require.Nil(t, c.Init())
+	_, _, err := NewAPIClient(c).NodeApi.ApiVersion(context.Background())
+	assert.Nil(t, err)
+
+	// The second time, the server is not contacted
+	server.Close()
+	c = newPinningTestConfiguration(server)
+	out.Reset()
+	require.Nil(t, c.TrustOnFirstUse(context.Background(), file, &out))
+	assert.Equal(t, "", out.String())
+	assert.Equal(t, []string{fp}, c.ServerPins)
+
+	// Unable to connect
+	c = newPinningTestConfiguration(server)
+	assert.Error(t, c.TrustOnFirstUse(context.Background(), file+"2", nil))
+}
+
+// Creates a proxy that tunnels the CONNECT requests and counts them.
+func newConnectProxy(t *testing.T, count *int32) *httptest.Server {
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		atomic.AddInt32(count, 1)
+		target, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		defer target.Close()
+		w.WriteHeader(http.StatusOK)
+		conn, buf, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		go io.Copy(target, buf)
+		io.Copy(conn, target)
+	}))
+	t.Cleanup(proxy.Close)
+	return proxy
+}
+
+func TestConfiguration_TrustOnFirstUse_Proxy(t *testing.T) {
+	server := newPinningTestServer(t)
+	fp := crypto.FormatFingerprint(crypto.SPKIFingerprint(server.Certificate()))
+	var count int32
+	proxy := newConnectProxy(t, &count)
+
+	c := newPinningTestConfiguration(server)
+	c.ProxyURL = proxy.URL
+	require.Nil(t, c.TrustOnFirstUse(context.Background(), filepath.Join(t.TempDir(), "known_nodes"), nil))
+	assert.Equal(t, []string{fp}, c.ServerPins)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&count))
+
+	// The proxy is not bypassed
+	c = newPinningTestConfiguration(server)
+	c.ProxyURL = "http://" + strings.TrimPrefix(server.URL, "https://")
+	assert.Error(t, c.TrustOnFirstUse(context.Background(), filepath.Join(t.TempDir(), "known_nodes"), nil))
+	assert.Nil(t, c.ServerPins)
+}
+
+func TestConfiguration_TrustOnFirstUse_Endpoints(t *testing.T) {
+	server := newPinningTestServer(t)
+	mirror := newPinningTestServer(t)
+	fp := crypto.FormatFingerprint(crypto.SPKIFingerprint(server.Certificate()))
+	file := filepath.Join(t.TempDir(), "known_nodes")
+
+	c := newPinningTestConfiguration(server)
+	c.Endpoints = []string{mirror.URL}
+	var out bytes.Buffer
+	require.Nil(t, c.TrustOnFirstUse(context.Background(), file, &out))
+	assert.Contains(t, out.String(), strings.TrimPrefix(server.URL, "https://"))
+	assert.Contains(t, out.String(), strings.TrimPrefix(mirror.URL, "https://"))
+	assert.Equal(t, []string{fp}, c.ServerPins)
+
+	known, err := LoadKnownNodes(file)
+	require.Nil(t, err)
+	assert.Equal(t, []string{fp}, known.Fingerprints(strings.TrimPrefix(mirror.URL, "https://")))
+
+	// The file is not modified if all nodes are known
+	mirror.Close()
+	info, err := os.Stat(file)
+	require.Nil(t, err)
+	c = newPinningTestConfiguration(server)
+	c.Endpoints = []string{mirror.URL}
+	out.Reset()
+	require.Nil(t, c.TrustOnFirstUse(context.Background(), file, &out))
+	assert.Equal(t, "", out.String())
+	info2, err := os.Stat(file)
+	require.Nil(t, err)
+	assert.Equal(t, info.ModTime(), info2.ModTime())
+
+	// Unable to connect to the endpoint
+	c = newPinningTestConfiguration(server)
+	c.Endpoints = []string{mirror.URL}
+	assert.Error(t, c.TrustOnFirstUse(context.Background(), file+"2", nil))
+}
@@ -0,0 +1,134 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+/*
+Calls an arbitrary endpoint of the node. It can be used to access endpoints that
+are not yet wrapped by this library.
+
+The path is relative to the BasePath of the configuration and may contain a
+query string, which is merged with query. The request uses the same default
+headers, user agent, TLS setup, retry policy, failover endpoints and
+middlewares used by the other API calls. The operation name passed to the
+middlewares is the method followed by the path, e.g. "GET /apps".
+
+The body may be nil, a []byte or io.Reader, sent as application/octet-stream,
+a string, sent as text/plain, or any other value, sent as JSON. Empty bodies
+are sent without content.
+
+On success, the response body is decoded into out. If out is a *[]byte or an
+io.Writer, the raw body is copied into it instead. If out is nil, the body is
+left untouched in the returned response. Responses with status 300 or above
+are returned as *APIError.
+
+The body of the returned response can always be read, even after it has
+been consumed by this method.
+*/
+func (c *APIClient) Do(ctx context.Context, method, path string, query url.Values,
+	body any, out any) (*http.Response, error) {
+
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	method = strings.ToUpper(method)
+	operation := method + " " + path
+	headerParams := map[string]string{
+		"Accept": "application/json",
+	}
+	if r, ok := body.(io.Reader); ok {
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+	switch b := body.(type) {
+	case nil:
+	case []byte:
+		headerParams["Content-Type"] = "application/octet-stream"
+		if len(b) == 0 {
+			body = nil
+		}
+	case string:
+		headerParams["Content-Type"] = "text/plain; charset=utf-8"
+		if b == "" {
+			body = nil
+		}
+	default:
+		headerParams["Content-Type"] = "application/json; charset=utf-8"
+	}
+	r, err := c.prepareRequest(ctx, c.cfg.BasePath+path, method, body,
+		headerParams, query, url.Values{}, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.callAPI(operation, r)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return resp, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+	if resp.StatusCode >= 300 {
+		return resp, newAPIError(operation, resp, respBody)
+	}
+	switch o := out.(type) {
+	case nil:
+		return resp, nil
+	case *[]byte:
+		*o = respBody
+		return resp, nil
+	case io.Writer:
+		_, err = o.Write(respBody)
+		return resp, err
+	}
+	if len(respBody) == 0 {
+		return resp, nil
+	}
+	contentType := resp.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	return resp, c.decode(out, respBody, contentType)
+}
@@ -0,0 +1,183 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRawRequestTestClient(t *testing.T) *APIClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		switch r.URL.Path {
+		case "/api/echo":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{
+				"method":      r.Method,
+				"query":       r.URL.Query(),
+				"body":        string(body),
+				"contentType": r.Header.Get("Content-Type"),
+				"userAgent":   r.Header.Get("User-Agent"),
+				"custom":      r.Header.Get("X-Custom"),
+			})
+		case "/api/raw":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Write([]byte{1, 2, 3})
+		default:
+			w.Header().Set("Content-Type", "application/problem+json")
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"title":"Not Found","detail":"no route"}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+	cfg := NewConfiguration()
+	cfg.BasePath = server.URL + "/api"
+	cfg.HTTPClient = server.Client()
+	cfg.UserAgent = "test-agent"
+	cfg.AddDefaultHeader("X-Custom", "custom")
+	return NewAPIClient(cfg)
+}
+
+type rawEcho struct {
+	Method      string              `json:"method"`
+	Query       map[string][]string `json:"query"`
+	Body        string              `json:"body"`
+	ContentType string              `json:"contentType"`
+	UserAgent   string              `json:"userAgent"`
+	Custom      string              `json:"custom"`
+}
+
+func TestAPIClient_Do(t *testing.T) {
+	c := newRawRequestTestClient(t)
+	ctx := context.Background()
+
+	var echo rawEcho
+	resp, err := c.Do(ctx, "get", "echo?a=1", url.Values{"b": {"2"}}, nil, &echo)
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "GET", echo.Method)
+	assert.Equal(t, map[string][]string{"a": {"1"}, "b": {"2"}}, echo.Query)
+	assert.Equal(t, "test-agent", echo.UserAgent)
+	assert.Equal(t, "custom", echo.Custom)
+
+	// The body can still be read
+	b, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	assert.Contains(t, string(b), `"method":"GET"`)
+
+	echo = rawEcho{}
+	_, err = c.Do(ctx, http.MethodPost, "/echo", nil, map[string]int{"x": 1}, &echo)
+	require.Nil(t, err)
+	assert.Equal(t, "POST", echo.Method)
+	assert.JSONEq(t, `{"x":1}`, echo.Body)
+	assert.Contains(t, echo.ContentType, "application/json")
+
+	echo = rawEcho{}
+	_, err = c.Do(ctx, http.MethodPut, "/echo", nil, []byte("abc"), &echo)
+	require.Nil(t, err)
+	assert.Equal(t, "abc", echo.Body)
+	assert.Equal(t, "application/octet-stream", echo.ContentType)
+
+	var raw []byte
+	_, err = c.Do(ctx, http.MethodGet, "/raw", nil, nil, &raw)
+	require.Nil(t, err)
+	assert.Equal(t, []byte{1, 2, 3}, raw)
+
+	var buff bytes.Buffer
+	_, err = c.Do(ctx, http.MethodGet, "/raw", nil, nil, &buff)
+	require.Nil(t, err)
+	assert.Equal(t, []byte{1, 2, 3}, buff.Bytes())
+}
+
+func TestAPIClient_Do_Body(t *testing.T) {
+	c := newRawRequestTestClient(t)
+	ctx := context.Background()
+
+	for _, test := range []struct {
+		body        any
+		expected    string
+		contentType string
+	}{
+		{42, "42\n", "application/json"},
+		{true, "true\n", "application/json"},
+		{1.5, "1.5\n", "application/json"},
+		{[]string{"a"}, "[\"a\"]\n", "application/json"},
+		{json.RawMessage(`{"a":1}`), "{\"a\":1}\n", "application/json"},
+		{"abc", "abc", "text/plain"},
+		{strings.NewReader("abc"), "abc", "application/octet-stream"},
+		{[]byte{}, "", "application/octet-stream"},
+		{"", "", "text/plain"},
+		{bytes.NewReader(nil), "", "application/octet-stream"},
+	} {
+		var echo rawEcho
+		_, err := c.Do(ctx, http.MethodPost, "/echo", nil, test.body, &echo)
+		require.Nil(t, err, "%#v", test.body)
+		assert.Equal(t, test.expected, echo.Body, "%#v", test.body)
+		assert.Contains(t, echo.ContentType, test.contentType, "%#v", test.body)
+	}
+}
+
+func TestAPIClient_Do_Error(t *testing.T) {
+	c := newRawRequestTestClient(t)
+
+	resp, err := c.Do(context.Background(), http.MethodGet, "/unknown", nil, nil, nil)
+	require.NotNil(t, resp)
+	assert.ErrorIs(t, err, ErrNotFound)
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "GET /unknown", apiErr.Operation)
+	assert.Equal(t, "no route", apiErr.Detail)
+}
+
+func TestAPIClient_Do_Middleware(t *testing.T) {
+	c := newRawRequestTestClient(t)
+	var operation string
+	c.Use(func(next Handler) Handler {
+		return func(op string, r *http.Request) (*http.Response, error) {
+			operation = op
+			return next(op, r)
+		}
+	})
+	_, err := c.Do(context.Background(), http.MethodGet, "/raw", nil, nil, nil)
+	require.Nil(t, err)
+	assert.Equal(t, "GET /raw", operation)
+}
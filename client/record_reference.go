@@ -0,0 +1,152 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+)
+
+/*
+Checks if the reference is valid and belongs to the network of the node. It
+returns an error that wraps ErrNetworkMismatch if the networks differ.
+*/
+func checkReferenceNetwork(ctx context.Context, node NodeAPI, ref models.UniversalRecordReference) (*http.Response, error) {
+	if err := ref.Validate(); err != nil {
+		return nil, err
+	}
+	network, resp, err := nodeNetwork(ctx, node)
+	if err != nil {
+		return resp, err
+	}
+	if !strings.EqualFold(network, ref.Network) {
+		return resp, fmt.Errorf("%w: the record %s is not in the network %s of the node",
+			ErrNetworkMismatch, ref, network)
+	}
+	return nil, nil
+}
+
+// Returns the network of the node. The network reported by a NodeApiService is
+// cached by its APIClient.
+func nodeNetwork(ctx context.Context, node NodeAPI) (string, *http.Response, error) {
+	if s, ok := node.(*NodeApiService); ok {
+		return s.client.nodeNetwork(ctx)
+	}
+	details, resp, err := node.NodeDetails(ctx)
+	return details.Network, resp, err
+}
+
+// Call to NodeApi.NodeDetails() shared by the concurrent callers of nodeNetwork().
+type networkCall struct {
+	done    chan struct{}
+	network string
+	err     error
+}
+
+/*
+Returns the network of the node, calling NodeApi.NodeDetails() only until it
+succeeds. Concurrent callers wait for the same call instead of making their own,
+and the lock is not held during the call. The network is forgotten by
+ChangeBasePath().
+*/
+func (c *APIClient) nodeNetwork(ctx context.Context) (string, *http.Response, error) {
+	c.networkMu.Lock()
+	if c.network != "" {
+		network := c.network
+		c.networkMu.Unlock()
+		return network, nil, nil
+	}
+	if call := c.networkCall; call != nil {
+		c.networkMu.Unlock()
+		select {
+		case <-call.done:
+			return call.network, nil, call.err
+		case <-ctx.Done():
+			return "", nil, ctx.Err()
+		}
+	}
+	call := &networkCall{done: make(chan struct{})}
+	c.networkCall = call
+	c.networkMu.Unlock()
+
+	details, resp, err := c.NodeApi.NodeDetails(ctx)
+	call.network, call.err = details.Network, err
+	c.networkMu.Lock()
+	// ChangeBasePath() may have been called meanwhile.
+	if c.networkCall == call {
+		c.networkCall = nil
+		if err == nil {
+			c.network = details.Network
+		}
+	}
+	c.networkMu.Unlock()
+	close(call.done)
+	return call.network, resp, err
+}
+
+/*
+Gets the record pointed by the universal reference. It fails with an error
+that wraps ErrNetworkMismatch if the reference does not belong to the network
+reported by NodeApi.NodeDetails(), which is called once per client.
+*/
+func (a *RecordApiService) GetByReference(ctx context.Context, ref models.UniversalRecordReference) (models.RecordModel, *http.Response, error) {
+	if resp, err := checkReferenceNetwork(ctx, a.client.NodeApi, ref); err != nil {
+		return models.RecordModel{}, resp, err
+	}
+	return a.RecordGet(ctx, ref.Chain, ref.Serial)
+}
+
+/*
+Gets the JSON record pointed by the universal reference. See GetByReference().
+*/
+func (a *RecordApiService) GetAsJsonByReference(ctx context.Context, ref models.UniversalRecordReference) (models.RecordModelAsJson, *http.Response, error) {
+	if resp, err := checkReferenceNetwork(ctx, a.client.NodeApi, ref); err != nil {
+		return models.RecordModelAsJson{}, resp, err
+	}
+	return a.RecordGetAsJson(ctx, ref.Chain, ref.Serial)
+}
+
+/*
+Gets the JSON record pointed by the universal reference and decodes its
+payload into T. See GetAsJSON() and RecordApiService.GetByReference().
+*/
+func GetAsJSONByReference[T any](ctx context.Context, c Client, ref models.UniversalRecordReference,
+	opts *TypedRecordOpts) (TypedRecord[T], *http.Response, error) {
+
+	if resp, err := checkReferenceNetwork(ctx, c.Node(), ref); err != nil {
+		return TypedRecord[T]{}, resp, err
+	}
+	return GetAsJSON[T](ctx, c, ref.Chain, ref.Serial, opts)
+}
@@ -0,0 +1,156 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/interlockledger/go-interlockledger-rest-client/client"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/clienttest"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordApiGetByReference(t *testing.T) {
+	node, c := newTestNode(t)
+	ctx := context.Background()
+	chain := node.CreateChain("chain")
+	added, err := node.AddJSONRecord(chain, 8, 1000, map[string]any{"name": "a"})
+	require.Nil(t, err)
+
+	ref, err := added.UniversalReference()
+	require.Nil(t, err)
+	assert.Equal(t, node.Network(), ref.Network)
+
+	calls := node.Calls("Node_Details")
+	rec, _, err := c.RecordApi.GetByReference(ctx, ref)
+	require.Nil(t, err)
+	assert.Equal(t, added.Hash, rec.Hash)
+
+	recJson, _, err := c.RecordApi.GetAsJsonByReference(ctx, ref)
+	require.Nil(t, err)
+	assert.Equal(t, added.Reference, recJson.Reference)
+
+	typed, _, err := client.GetAsJSONByReference[typedPayload](ctx, c, ref, nil)
+	require.Nil(t, err)
+	assert.Equal(t, "a", typed.Payload.Name)
+
+	// The network of the node is fetched only once.
+	assert.Equal(t, calls+1, node.Calls("Node_Details"))
+	other := ref
+	other.Network = "Other"
+	_, _, err = c.RecordApi.GetByReference(ctx, other)
+	assert.ErrorIs(t, err, client.ErrNetworkMismatch)
+	_, _, err = client.GetAsJSONByReference[typedPayload](ctx, c, other, nil)
+	assert.ErrorIs(t, err, client.ErrNetworkMismatch)
+	assert.Equal(t, calls+1, node.Calls("Node_Details"))
+
+	// Invalid references are rejected before any call.
+	_, _, err = c.RecordApi.GetAsJsonByReference(ctx, models.UniversalRecordReference{})
+	assert.ErrorIs(t, err, models.ErrInvalidRecordReference)
+	assert.Equal(t, calls+1, node.Calls("Node_Details"))
+
+	missing := ref
+	missing.Serial = 1000
+	_, _, err = c.RecordApi.GetByReference(ctx, missing)
+	assert.ErrorIs(t, err, client.ErrNotFound)
+
+	// Changing the base path forgets the network.
+	c.ChangeBasePath(node.URL())
+	_, _, err = c.RecordApi.GetByReference(ctx, ref)
+	require.Nil(t, err)
+	assert.Equal(t, calls+2, node.Calls("Node_Details"))
+}
+
+func TestRecordApiGetByReference_Concurrent(t *testing.T) {
+	node, c := newTestNode(t)
+	ctx := context.Background()
+	chain := node.CreateChain("chain")
+	added, err := node.AddJSONRecord(chain, 8, 1000, map[string]any{"name": "a"})
+	require.Nil(t, err)
+	ref, err := added.UniversalReference()
+	require.Nil(t, err)
+
+	// Runs GetByReference concurrently while the node details are slow.
+	run := func(status int) []error {
+		node.SetErrorHook(func(operation string, request *http.Request) int {
+			if operation == "Node_Details" {
+				time.Sleep(100 * time.Millisecond)
+				return status
+			}
+			return 0
+		})
+		defer node.SetErrorHook(nil)
+		errs := make([]error, 10)
+		var wg sync.WaitGroup
+		for i := range errs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, _, errs[i] = c.RecordApi.GetByReference(ctx, ref)
+			}(i)
+		}
+		wg.Wait()
+		return errs
+	}
+
+	// A failed call is shared by all callers and is not repeated by each one.
+	calls := node.Calls("Node_Details")
+	for _, err := range run(http.StatusInternalServerError) {
+		assert.ErrorIs(t, err, client.ErrServerError)
+	}
+	assert.Equal(t, calls+1, node.Calls("Node_Details"))
+
+	// The network is fetched again after a failure.
+	for _, err := range run(0) {
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, calls+2, node.Calls("Node_Details"))
+	_, _, err = c.RecordApi.GetByReference(ctx, ref)
+	require.Nil(t, err)
+	assert.Equal(t, calls+2, node.Calls("Node_Details"))
+}
+
+func TestGetAsJSONByReferenceMock(t *testing.T) {
+	var c clienttest.MockClient
+	c.NodeAPI.NodeDetailsFunc = func(ctx context.Context) (models.NodeDetailsModel, *http.Response, error) {
+		return models.NodeDetailsModel{}, nil, client.ErrServerError
+	}
+	_, _, err := client.GetAsJSONByReference[typedPayload](context.Background(), &c,
+		models.UniversalRecordReference{Network: "net", Chain: "chain", Serial: 1}, nil)
+	assert.ErrorIs(t, err, client.ErrServerError)
+	assert.Empty(t, c.CallsTo("Do"))
+}
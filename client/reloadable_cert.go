@@ -0,0 +1,210 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"crypto/tls"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/interlockledger/go-interlockledger-rest-client/crypto"
+)
+
+/*
+ClientCertificateSource provides the client certificate on each TLS handshake.
+It allows the replacement of the client certificate without the need to
+rebuild the HTTPClient.
+*/
+type ClientCertificateSource interface {
+	// Returns the client certificate to be used by the handshake. It has the
+	// same semantics of tls.Config.GetClientCertificate.
+	GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error)
+}
+
+// State of a file watched by ReloadableCertificate.
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+/*
+ReloadableCertificate is a ClientCertificateSource that loads the certificate
+from files and reloads it when they change. The files are checked for changes
+during the handshakes, at most once per CheckInterval, thus connections
+already established keep using the previous certificate while the new
+connections use the new one.
+
+It is safe for concurrent use.
+*/
+type ReloadableCertificate struct {
+	// Minimum interval between two checks of the files. If zero, the files
+	// will be reloaded only by Reload().
+	CheckInterval time.Duration
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	files     []string
+	states    []fileState
+	lastCheck time.Time
+	load      func() (tls.Certificate, error)
+}
+
+/*
+Creates a new ReloadableCertificate from the given certificate and key files,
+both in PEM format.
+*/
+func NewReloadableCertificate(certificateFile string, keyFile string) (*ReloadableCertificate, error) {
+	return newReloadableCertificate([]string{certificateFile, keyFile},
+		func() (tls.Certificate, error) {
+			return crypto.LoadCertificateWithKey(certificateFile, keyFile)
+		})
+}
+
+/*
+Creates a new ReloadableCertificate from the given certificate and key files,
+both in PEM format. The key file may be encrypted with the given password. See
+crypto.ParsePrivateKeyWithPassword() for the supported formats.
+*/
+func NewReloadableCertificateWithPassword(certificateFile string, keyFile string, password string) (*ReloadableCertificate, error) {
+	return newReloadableCertificate([]string{certificateFile, keyFile},
+		func() (tls.Certificate, error) {
+			return crypto.LoadCertificateWithEncryptedKey(certificateFile, keyFile, password)
+		})
+}
+
+/*
+Creates a new ReloadableCertificate from the given PKCS #12 file.
+*/
+func NewReloadableCertificatePKCS12(file string, password string) (*ReloadableCertificate, error) {
+	return newReloadableCertificate([]string{file},
+		func() (tls.Certificate, error) {
+			return crypto.LoadCertificateWithKeyFromPKCS12(file, password)
+		})
+}
+
+func newReloadableCertificate(files []string, load func() (tls.Certificate, error)) (*ReloadableCertificate, error) {
+	r := &ReloadableCertificate{files: files, load: load}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Returns the current state of the files.
+func (r *ReloadableCertificate) fileStates() ([]fileState, error) {
+	states := make([]fileState, len(r.files))
+	for i, f := range r.files {
+		info, err := os.Stat(f)
+		if err != nil {
+			return nil, err
+		}
+		states[i] = fileState{modTime: info.ModTime(), size: info.Size()}
+	}
+	return states, nil
+}
+
+/*
+Reloads the certificate from its files. On failure, the current certificate is
+kept.
+*/
+func (r *ReloadableCertificate) Reload() error {
+	states, err := r.fileStates()
+	if err != nil {
+		return err
+	}
+	cert, err := r.load()
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	r.states = states
+	r.lastCheck = time.Now()
+	return nil
+}
+
+/*
+Reloads the certificate if its files have changed since the last load. It
+returns true if the certificate was reloaded.
+*/
+func (r *ReloadableCertificate) ReloadIfChanged() (bool, error) {
+	states, err := r.fileStates()
+	r.mu.Lock()
+	r.lastCheck = time.Now()
+	changed := err == nil && !equalFileStates(states, r.states)
+	r.mu.Unlock()
+	if err != nil || !changed {
+		return false, err
+	}
+	if err := r.Reload(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func equalFileStates(a, b []fileState) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].modTime.Equal(b[i].modTime) || a[i].size != b[i].size {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the current certificate.
+func (r *ReloadableCertificate) Certificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert
+}
+
+/*
+Implements ClientCertificateSource. If CheckInterval has elapsed since the last
+check, the files are checked for changes before the certificate is returned.
+Reload errors are ignored and the current certificate is used instead as the
+files may be in the middle of their replacement.
+*/
+func (r *ReloadableCertificate) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	if r.CheckInterval > 0 {
+		r.mu.RLock()
+		check := time.Since(r.lastCheck) >= r.CheckInterval
+		r.mu.RUnlock()
+		if check {
+			r.ReloadIfChanged()
+		}
+	}
+	return r.Certificate(), nil
+}
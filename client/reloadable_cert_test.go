@@ -0,0 +1,192 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Writes a new self-signed certificate with the given common name.
+func writeTestCertificate(t *testing.T, certFile, keyFile string, cn string, modTime time.Time) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.Nil(t, err)
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	require.Nil(t, err)
+	require.Nil(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.Nil(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer}), 0600))
+	require.Nil(t, os.Chtimes(certFile, modTime, modTime))
+	require.Nil(t, os.Chtimes(keyFile, modTime, modTime))
+}
+
+func TestReloadableCertificate(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	now := time.Now()
+	writeTestCertificate(t, certFile, keyFile, "first", now)
+
+	r, err := NewReloadableCertificate(certFile, keyFile)
+	require.Nil(t, err)
+	first := r.Certificate()
+	require.NotNil(t, first)
+
+	changed, err := r.ReloadIfChanged()
+	assert.Nil(t, err)
+	assert.False(t, changed)
+
+	// No CheckInterval
+	writeTestCertificate(t, certFile, keyFile, "second", now.Add(time.Minute))
+	c, err := r.GetClientCertificate(nil)
+	assert.Nil(t, err)
+	assert.Same(t, first, c)
+
+	// With CheckInterval
+	r.CheckInterval = time.Nanosecond
+	c, err = r.GetClientCertificate(nil)
+	assert.Nil(t, err)
+	assert.NotSame(t, first, c)
+	assert.Same(t, r.Certificate(), c)
+
+	// Errors keep the current certificate
+	require.Nil(t, os.WriteFile(keyFile, []byte("invalid"), 0600))
+	changed, err = r.ReloadIfChanged()
+	assert.Error(t, err)
+	assert.False(t, changed)
+	assert.Same(t, c, r.Certificate())
+	c2, err := r.GetClientCertificate(nil)
+	assert.Nil(t, err)
+	assert.Same(t, c, c2)
+
+	require.Nil(t, os.Remove(keyFile))
+	_, err = r.ReloadIfChanged()
+	assert.Error(t, err)
+	assert.Error(t, r.Reload())
+
+	_, err = NewReloadableCertificate(certFile, keyFile)
+	assert.Error(t, err)
+}
+
+func TestNewReloadableCertificatePKCS12(t *testing.T) {
+	r, err := NewReloadableCertificatePKCS12(path.Join("..", "samples", "sample.pfx"), "password")
+	require.Nil(t, err)
+	assert.NotNil(t, r.Certificate())
+
+	_, err = NewReloadableCertificatePKCS12(path.Join("..", "samples", "sample.pfx"), "bad")
+	assert.Error(t, err)
+}
+
+func TestConfiguration_CertReloadInterval(t *testing.T) {
+	var mu sync.Mutex
+	var names []string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		names = append(names, r.TLS.PeerCertificates[0].Subject.CommonName)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`"7.2.0"`))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	dir := t.TempDir()
+	now := time.Now()
+	cfg := NewConfiguration()
+	cfg.BasePath = server.URL
+	cfg.NoServerVerification = true
+	cfg.CertFile = filepath.Join(dir, "cert.pem")
+	cfg.KeyFile = filepath.Join(dir, "key.pem")
+	cfg.CertReloadInterval = time.Nanosecond
+	writeTestCertificate(t, cfg.CertFile, cfg.KeyFile, "first", now)
+	require.Nil(t, cfg.Init())
+	require.IsType(t, &ReloadableCertificate{}, cfg.CertificateSource)
+	c := NewAPIClient(cfg)
+
+	_, _, err := c.NodeApi.ApiVersion(context.Background())
+	require.Nil(t, err)
+
+	// The established connection keeps the old certificate
+	writeTestCertificate(t, cfg.CertFile, cfg.KeyFile, "second", now.Add(time.Minute))
+	_, _, err = c.NodeApi.ApiVersion(context.Background())
+	require.Nil(t, err)
+
+	// New connections use the new one
+	cfg.HTTPClient.CloseIdleConnections()
+	_, _, err = c.NodeApi.ApiVersion(context.Background())
+	require.Nil(t, err)
+
+	assert.Equal(t, []string{"first", "first", "second"}, names)
+
+	// PFX
+	cfg = NewConfiguration()
+	cfg.PFXFile = path.Join("..", "samples", "sample.pfx")
+	cfg.PFXPassword = "password"
+	cfg.CertReloadInterval = time.Minute
+	require.Nil(t, cfg.Init())
+	assert.IsType(t, &ReloadableCertificate{}, cfg.CertificateSource)
+
+	cfg.PFXPassword = "bad"
+	cfg.CertificateSource = nil
+	assert.Error(t, cfg.Init())
+
+	// SetClientCert replaces the source
+	require.Nil(t, cfg.SetClientCertificatePKCS12(cfg.PFXFile, "password"))
+	assert.Nil(t, cfg.CertificateSource)
+}
@@ -0,0 +1,346 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+/*
+Retry policy used by the client to repeat requests that failed due to transient
+errors, such as connection resets, TLS handshake timeouts or the status codes
+502, 503 and 504.
+
+By default only idempotent requests (GET, HEAD and OPTIONS) are retried. Other
+methods, such as the POST used by RecordAdd or OpaqueService.Create, will only
+be retried if RetryNonIdempotent is set. The status 409 (see
+ErrOptimisticLockError) is never retried.
+*/
+type RetryPolicy struct {
+	// Maximum number of attempts, including the first one. Values lower than 2
+	// disable the retries.
+	MaxAttempts int `json:"maxAttempts,omitempty"`
+	// Delay before the first retry. It doubles after each attempt. In JSON and
+	// YAML, it can be written either as a duration string such as "200ms" or as
+	// a number of nanoseconds.
+	InitialBackoff time.Duration `json:"initialBackoff,omitempty"`
+	// Maximum delay between two attempts. It also limits the delay requested
+	// by the server via Retry-After. It has the same format of InitialBackoff.
+	MaxBackoff time.Duration `json:"maxBackoff,omitempty"`
+	// Fraction of the backoff that will be randomized, from 0.0 to 1.0.
+	Jitter float64 `json:"jitter,omitempty"`
+	// If true, non idempotent requests will also be retried.
+	RetryNonIdempotent bool `json:"retryNonIdempotent,omitempty"`
+	// List of status codes that will be retried. If empty, 502, 503 and 504
+	// will be used.
+	RetryableStatusCodes []int `json:"retryableStatusCodes,omitempty"`
+}
+
+// Default status codes that can be retried.
+var defaultRetryableStatusCodes = []int{
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+/*
+Creates a new RetryPolicy with the default values. It will try at most 3 times,
+starting with a backoff of 200ms up to 5s with a 20% jitter.
+*/
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Jitter:         0.2,
+	}
+}
+
+// Implements json.Unmarshaler.
+func (p *RetryPolicy) UnmarshalJSON(b []byte) error {
+	// The alias removes this method to avoid an infinite recursion.
+	type plain RetryPolicy
+	tmp := struct {
+		*plain
+		InitialBackoff json.RawMessage `json:"initialBackoff,omitempty"`
+		MaxBackoff     json.RawMessage `json:"maxBackoff,omitempty"`
+	}{plain: (*plain)(p)}
+	if err := json.Unmarshal(b, &tmp); err != nil {
+		return err
+	}
+	var err error
+	if p.InitialBackoff, err = parseJSONDuration(tmp.InitialBackoff, p.InitialBackoff); err != nil {
+		return fmt.Errorf("invalid initialBackoff: %w", err)
+	}
+	if p.MaxBackoff, err = parseJSONDuration(tmp.MaxBackoff, p.MaxBackoff); err != nil {
+		return fmt.Errorf("invalid maxBackoff: %w", err)
+	}
+	return nil
+}
+
+/*
+Parses a duration written either as a string accepted by time.ParseDuration()
+or as a number of nanoseconds. It returns def if b is empty or null.
+*/
+func parseJSONDuration(b json.RawMessage, def time.Duration) (time.Duration, error) {
+	if len(b) == 0 || string(b) == "null" {
+		return def, nil
+	}
+	if b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return 0, err
+		}
+		return time.ParseDuration(s)
+	}
+	var n int64
+	if err := json.Unmarshal(b, &n); err != nil {
+		return 0, err
+	}
+	return time.Duration(n), nil
+}
+
+type retryPolicyKey struct{}
+
+/*
+Returns a copy of ctx that carries a retry policy that overrides the one in
+Configuration for all calls made with it. It can be used to opt in the
+retry of a single non idempotent call. A nil policy disables the retries.
+*/
+func WithRetryPolicy(ctx context.Context, policy *RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+// Returns the retry policy that must be used by the given request.
+func (c *APIClient) retryPolicy(request *http.Request) *RetryPolicy {
+	if p, ok := request.Context().Value(retryPolicyKey{}).(*RetryPolicy); ok {
+		return p
+	}
+	return c.cfg.RetryPolicy
+}
+
+// Returns true if the method of the request is idempotent.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// Returns true if the request can be retried by this policy.
+func (p *RetryPolicy) canRetry(request *http.Request) bool {
+	if p == nil || p.MaxAttempts < 2 {
+		return false
+	}
+	if !isIdempotent(request.Method) && !p.RetryNonIdempotent {
+		return false
+	}
+	// The body must be replayable.
+	return request.Body == nil || request.GetBody != nil
+}
+
+// Returns true if the response status can be retried.
+func (p *RetryPolicy) isRetryableStatus(statusCode int) bool {
+	if statusCode == http.StatusConflict {
+		return false
+	}
+	codes := p.RetryableStatusCodes
+	if len(codes) == 0 {
+		codes = defaultRetryableStatusCodes
+	}
+	for _, c := range codes {
+		if c == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if the transport error can be retried.
+func isRetryableError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	return !isCertificateError(err)
+}
+
+/*
+Returns true if the error is caused by the verification of the server
+certificate. Those errors are permanent, thus there is no point in retrying.
+*/
+func isCertificateError(err error) bool {
+	var verificationErr *tls.CertificateVerificationError
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var invalidErr x509.CertificateInvalidError
+	var hostnameErr x509.HostnameError
+	var systemRootsErr x509.SystemRootsError
+	var constraintErr x509.ConstraintViolationError
+	var insecureAlgorithmErr x509.InsecureAlgorithmError
+	return errors.Is(err, ErrServerPinMismatch) ||
+		errors.As(err, &verificationErr) ||
+		errors.As(err, &unknownAuthorityErr) ||
+		errors.As(err, &invalidErr) ||
+		errors.As(err, &hostnameErr) ||
+		errors.As(err, &systemRootsErr) ||
+		errors.As(err, &constraintErr) ||
+		errors.As(err, &insecureAlgorithmErr)
+}
+
+// Computes the backoff of the given retry, starting from 0.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	d := p.InitialBackoff
+	for i := 0; i < retry && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		j := p.Jitter
+		if j > 1 {
+			j = 1
+		}
+		d = d - time.Duration(float64(d)*j*rand.Float64())
+	}
+	return d
+}
+
+/*
+Parses the header Retry-After. It returns the delay and true if the header is
+present and valid.
+*/
+func parseRetryAfter(headers http.Header, now time.Time) (time.Duration, bool) {
+	s := headers.Get("Retry-After")
+	if s == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(s); err == nil {
+		d := t.Sub(now)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// Waits for the given delay or until the context is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Creates a new copy of the request with a fresh body, ready to be sent again.
+func rewindRequest(request *http.Request) (*http.Request, error) {
+	r := request.Clone(request.Context())
+	if request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = body
+	}
+	return r, nil
+}
+
+/*
+Sends the request according to the retry policy. The last response or error is
+returned when all attempts are exhausted.
+*/
+func (c *APIClient) doWithRetry(operation string, request *http.Request) (*http.Response, error) {
+	policy := c.retryPolicy(request)
+	if !policy.canRetry(request) {
+		return c.send(operation, request)
+	}
+	ctx := request.Context()
+	for attempt := 1; ; attempt++ {
+		r := request
+		if attempt > 1 {
+			var err error
+			if r, err = rewindRequest(request); err != nil {
+				return nil, err
+			}
+		}
+		resp, err := c.send(operation, r)
+		if attempt >= policy.MaxAttempts {
+			return resp, err
+		}
+		delay := policy.backoff(attempt - 1)
+		if err != nil {
+			if !isRetryableError(ctx, err) {
+				return resp, err
+			}
+		} else if policy.isRetryableStatus(resp.StatusCode) {
+			if d, ok := parseRetryAfter(resp.Header, time.Now()); ok {
+				delay = d
+				if policy.MaxBackoff > 0 && delay > policy.MaxBackoff {
+					delay = policy.MaxBackoff
+				}
+			}
+			// This response will be discarded.
+			resp.Body.Close()
+		} else {
+			return resp, nil
+		}
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
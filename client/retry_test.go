@@ -0,0 +1,298 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRetryTestClient(t *testing.T, handler http.HandlerFunc) *APIClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	cfg := NewConfiguration()
+	cfg.BasePath = server.URL
+	cfg.HTTPClient = server.Client()
+	cfg.RetryPolicy = &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     10 * time.Millisecond,
+	}
+	return NewAPIClient(cfg)
+}
+
+func TestNewRetryPolicy(t *testing.T) {
+	p := NewRetryPolicy()
+	assert.Equal(t, 3, p.MaxAttempts)
+	assert.Equal(t, 200*time.Millisecond, p.InitialBackoff)
+	assert.Equal(t, 5*time.Second, p.MaxBackoff)
+	assert.Equal(t, 0.2, p.Jitter)
+	assert.False(t, p.RetryNonIdempotent)
+	assert.NotNil(t, NewConfiguration().RetryPolicy)
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	assert.Equal(t, 100*time.Millisecond, p.backoff(0))
+	assert.Equal(t, 200*time.Millisecond, p.backoff(1))
+	assert.Equal(t, 400*time.Millisecond, p.backoff(2))
+	assert.Equal(t, time.Second, p.backoff(10))
+	assert.Equal(t, time.Second, p.backoff(100))
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d := p.backoff(0)
+		assert.LessOrEqual(t, d, 100*time.Millisecond)
+		assert.GreaterOrEqual(t, d, 50*time.Millisecond)
+	}
+}
+
+func TestRetryPolicy_isRetryableStatus(t *testing.T) {
+	p := NewRetryPolicy()
+	assert.True(t, p.isRetryableStatus(502))
+	assert.True(t, p.isRetryableStatus(503))
+	assert.True(t, p.isRetryableStatus(504))
+	assert.False(t, p.isRetryableStatus(500))
+	assert.False(t, p.isRetryableStatus(404))
+
+	p.RetryableStatusCodes = []int{409, 500}
+	assert.True(t, p.isRetryableStatus(500))
+	assert.False(t, p.isRetryableStatus(409))
+	assert.False(t, p.isRetryableStatus(503))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	h := make(http.Header)
+
+	_, ok := parseRetryAfter(h, now)
+	assert.False(t, ok)
+
+	h.Set("Retry-After", "5")
+	d, ok := parseRetryAfter(h, now)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, d)
+
+	h.Set("Retry-After", now.Add(time.Minute).Format(http.TimeFormat))
+	d, ok = parseRetryAfter(h, now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Minute, d)
+
+	h.Set("Retry-After", "-1")
+	_, ok = parseRetryAfter(h, now)
+	assert.False(t, ok)
+
+	h.Set("Retry-After", "X")
+	_, ok = parseRetryAfter(h, now)
+	assert.False(t, ok)
+}
+
+func TestAPIClient_callAPI_RetryGet(t *testing.T) {
+	var count int32
+	c := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&count, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`"7.2.0"`))
+	})
+
+	v, resp, err := c.NodeApi.ApiVersion(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, "7.2.0", v)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&count))
+}
+
+func TestAPIClient_callAPI_RetryExhausted(t *testing.T) {
+	var count int32
+	c := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, resp, err := c.NodeApi.ApiVersion(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, 502, resp.StatusCode)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&count))
+}
+
+func TestAPIClient_callAPI_RetryPost(t *testing.T) {
+	var count int32
+	var lastBody []byte
+	c := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		lastBody, _ = io.ReadAll(r.Body)
+		if atomic.AddInt32(&count, 1) < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"serial":1}`))
+	})
+
+	// Not retried by default
+	_, resp, err := c.RecordApi.RecordAdd(context.Background(), "chain", &models.NewRecordModel{})
+	assert.Error(t, err)
+	assert.Equal(t, 503, resp.StatusCode)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&count))
+
+	// Opt in
+	atomic.StoreInt32(&count, 0)
+	p := *c.cfg.RetryPolicy
+	p.RetryNonIdempotent = true
+	ctx := WithRetryPolicy(context.Background(), &p)
+	r, resp, err := c.RecordApi.RecordAdd(ctx, "chain", &models.NewRecordModel{})
+	require.Nil(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, int64(1), r.Serial)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&count))
+	assert.JSONEq(t, `{"applicationId":0}`, string(lastBody))
+}
+
+func TestAPIClient_callAPI_NoRetryConflict(t *testing.T) {
+	var count int32
+	c := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		w.WriteHeader(http.StatusConflict)
+	})
+	c.cfg.RetryPolicy.RetryNonIdempotent = true
+	c.cfg.RetryPolicy.RetryableStatusCodes = []int{409}
+
+	_, resp, err := c.OpaqueApi.Create(context.Background(), "chain", 1, 2, nil, 3)
+	assert.ErrorIs(t, err, ErrOptimisticLockError)
+	assert.Equal(t, 409, resp.StatusCode)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&count))
+}
+
+func TestAPIClient_callAPI_NoRetryPolicy(t *testing.T) {
+	var count int32
+	c := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, _, err := c.NodeApi.ApiVersion(WithRetryPolicy(context.Background(), nil))
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&count))
+}
+
+func TestAPIClient_callAPI_RetryCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var count int32
+	c := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		cancel()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	c.cfg.RetryPolicy.InitialBackoff = time.Second
+
+	_, _, err := c.NodeApi.ApiVersion(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&count))
+}
+
+func TestRetryPolicy_UnmarshalJSON(t *testing.T) {
+	var p RetryPolicy
+	require.NoError(t, json.Unmarshal([]byte(`{"maxAttempts":4,"initialBackoff":"200ms","maxBackoff":"1m30s","jitter":0.1}`), &p))
+	assert.Equal(t, RetryPolicy{MaxAttempts: 4, InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff: 90 * time.Second, Jitter: 0.1}, p)
+
+	p = *NewRetryPolicy()
+	require.NoError(t, json.Unmarshal([]byte(`{"initialBackoff":1000000}`), &p))
+	assert.Equal(t, time.Millisecond, p.InitialBackoff)
+	assert.Equal(t, 5*time.Second, p.MaxBackoff)
+
+	b, err := json.Marshal(NewRetryPolicy())
+	require.NoError(t, err)
+	p = RetryPolicy{}
+	require.NoError(t, json.Unmarshal(b, &p))
+	assert.Equal(t, *NewRetryPolicy(), p)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"initialBackoff":"200"}`), &p))
+	assert.Error(t, json.Unmarshal([]byte(`{"maxBackoff":true}`), &p))
+}
+
+func TestIsRetryableError(t *testing.T) {
+	ctx := context.Background()
+	wrap := func(err error) error {
+		return &url.Error{Op: "Get", URL: "https://localhost", Err: err}
+	}
+	assert.True(t, isRetryableError(ctx, wrap(io.ErrUnexpectedEOF)))
+	assert.False(t, isRetryableError(ctx, wrap(context.DeadlineExceeded)))
+	assert.False(t, isRetryableError(ctx, wrap(x509.UnknownAuthorityError{})))
+	assert.False(t, isRetryableError(ctx, wrap(x509.HostnameError{Host: "localhost"})))
+	assert.False(t, isRetryableError(ctx, wrap(x509.CertificateInvalidError{Reason: x509.Expired})))
+	assert.False(t, isRetryableError(ctx, wrap(&tls.CertificateVerificationError{Err: io.EOF})))
+	assert.False(t, isRetryableError(ctx, wrap(fmt.Errorf("%w: SHA256:x", ErrServerPinMismatch))))
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.False(t, isRetryableError(canceled, wrap(io.ErrUnexpectedEOF)))
+}
+
+func TestAPIClient_callAPI_NoRetryCertificateError(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	t.Cleanup(server.Close)
+	cfg := NewConfiguration()
+	cfg.BasePath = server.URL
+	// The default client does not trust the certificate of the test server.
+	cfg.HTTPClient = &http.Client{}
+	cfg.RetryPolicy = &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Second}
+	c := NewAPIClient(cfg)
+	var count int32
+	c.Use(func(next Handler) Handler {
+		return func(operation string, request *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&count, 1)
+			return next(operation, request)
+		}
+	})
+
+	_, _, err := c.NodeApi.ApiVersion(context.Background())
+	var certErr *tls.CertificateVerificationError
+	assert.ErrorAs(t, err, &certErr)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&count))
+}
@@ -0,0 +1,306 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/antihax/optional"
+	"github.com/interlockledger/go-interlockledger-rest-client/client"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/clienttest"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestNode(t *testing.T) (*clienttest.Node, *client.APIClient) {
+	node := clienttest.NewNode(&clienttest.Options{TLS: true})
+	t.Cleanup(node.Close)
+	return node, node.NewClient()
+}
+
+func TestNodeApiService(t *testing.T) {
+	node, c := newTestNode(t)
+	ctx := context.Background()
+	chain := node.CreateChain("chain")
+	node.AddApp(models.IInterlockAppTraits{Id: 1, Name: "App"})
+	node.AddPeer(models.PeerModel{Id: "peer"})
+
+	version, _, err := c.NodeApi.ApiVersion(ctx)
+	require.Nil(t, err)
+	assert.Equal(t, clienttest.APIVersion, version)
+
+	details, _, err := c.NodeApi.NodeDetails(ctx)
+	require.Nil(t, err)
+	assert.Equal(t, node.Network(), details.Network)
+	assert.Equal(t, []string{chain}, details.Chains)
+
+	apps, _, err := c.NodeApi.AppsList(ctx)
+	require.Nil(t, err)
+	require.Len(t, apps.ValidApps, 1)
+	assert.Equal(t, "App", apps.ValidApps[0].Name)
+
+	peers, _, err := c.NodeApi.PeersList(ctx)
+	require.Nil(t, err)
+	require.Len(t, peers, 1)
+
+	added, _, err := c.NodeApi.MirrorAdd(ctx, []string{"mirror1", "mirror2"})
+	require.Nil(t, err)
+	assert.Len(t, added, 2)
+	mirrors, _, err := c.NodeApi.MirrorsList(ctx)
+	require.Nil(t, err)
+	assert.Len(t, mirrors, 2)
+}
+
+func TestChainApiService(t *testing.T) {
+	_, c := newTestNode(t)
+	ctx := context.Background()
+
+	created, _, err := c.ChainApi.ChainCreate(ctx, &models.ChainCreatedModel{Name: "chain"})
+	require.Nil(t, err)
+	assert.NotEmpty(t, created.Id)
+	chain := created.Id
+
+	chains, _, err := c.ChainApi.ChainsList(ctx)
+	require.Nil(t, err)
+	require.Len(t, chains, 1)
+	assert.Equal(t, "chain", chains[0].Name)
+
+	apps, _, err := c.ChainApi.ChainActiveAppsAdd(ctx, chain, []int64{4, 8})
+	require.Nil(t, err)
+	assert.Equal(t, []int64{4, 8}, apps)
+	apps, _, err = c.ChainApi.ChainActiveAppsList(ctx, chain)
+	require.Nil(t, err)
+	assert.Equal(t, []int64{4, 8}, apps)
+
+	keys, _, err := c.ChainApi.ChainPermittedKeysAdd(ctx, chain, []models.KeyPermitModel{
+		{Name: "key", Id: "Key!id", PublicKey: "PubKey!key", Permissions: []string{"#1"}}})
+	require.Nil(t, err)
+	require.Len(t, keys, 1)
+	keys, _, err = c.ChainApi.ChainPermittedKeysList(ctx, chain)
+	require.Nil(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, "Key!id", keys[0].Id)
+
+	target, _, err := c.ChainApi.ChainCreate(ctx, &models.ChainCreatedModel{Name: "target"})
+	require.Nil(t, err)
+	interlocking, _, err := c.ChainApi.ChainInterlockingAdd(ctx, chain,
+		&models.ForceInterlockModel{TargetChain: target.Id})
+	require.Nil(t, err)
+	assert.Equal(t, target.Id, interlocking.InterlockedChainId)
+	assert.Equal(t, int64(1), interlocking.Serial)
+
+	page, _, err := c.ChainApi.ChainInterlockingsList(ctx, chain,
+		&client.ChainApiChainInterlockingsListOpts{HowManyFromLast: optional.NewInt32(5)})
+	require.Nil(t, err)
+	require.Len(t, page.Items, 1)
+	nodePage, _, err := c.NodeApi.InterlockingsList(ctx, target.Id, nil)
+	require.Nil(t, err)
+	require.Len(t, nodePage.Items, 1)
+	assert.Equal(t, chain, nodePage.Items[0].ChainId)
+
+	details, _, err := c.ChainApi.ChainDetails(ctx, chain)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), details.LastRecord)
+}
+
+func TestRecordApiService(t *testing.T) {
+	node, c := newTestNode(t)
+	ctx := context.Background()
+	chain := node.CreateChain("chain")
+
+	rec, _, err := c.RecordApi.RecordAdd(ctx, chain, &models.NewRecordModel{
+		ApplicationId: 1,
+		PayloadBytes:  models.EncodeBytes([]byte{0xF8, 0x34, 0x00}),
+	})
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), rec.Serial)
+	assert.Equal(t, int64(300), rec.PayloadTagId)
+
+	rec, _, err = c.RecordApi.RecordAddAsJson(ctx, chain, &client.RecordApiRecordAddAsJsonOpts{
+		ApplicationId: optional.NewInt64(1),
+		PayloadTagId:  optional.NewInt64(300),
+	}, map[string]any{"name": "value"})
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), rec.Serial)
+
+	got, _, err := c.RecordApi.RecordGet(ctx, chain, 1)
+	require.Nil(t, err)
+	assert.Equal(t, models.EncodeBytes([]byte{0xF8, 0x34, 0x00}), got.PayloadBytes)
+
+	gotJson, _, err := c.RecordApi.RecordGetAsJson(ctx, chain, 2)
+	require.Nil(t, err)
+	require.NotNil(t, gotJson.Payload)
+	assert.Equal(t, map[string]any{"name": "value"}, *gotJson.Payload)
+
+	list, _, err := c.RecordApi.RecordsList(ctx, chain, nil)
+	require.Nil(t, err)
+	assert.Len(t, list.Items, 3)
+
+	listJson, _, err := c.RecordApi.RecordsListAsJson(ctx, chain, &client.RecordApiRecordsListAsJsonOpts{
+		FirstSerial: optional.NewInt64(1),
+	})
+	require.Nil(t, err)
+	assert.Len(t, listJson.Items, 2)
+
+	query, _, err := c.RecordApi.RecordsQuery(ctx, chain, &client.RecordApiRecordsQueryOpts{
+		QueryAsInterlockQL: optional.NewString("USE default"),
+		HowMany:            optional.NewInt64(2),
+	})
+	require.Nil(t, err)
+	assert.Len(t, query.Items, 2)
+
+	queryJson, _, err := c.RecordApi.RecordsQueryAsJson(ctx, chain, &client.RecordApiRecordsQueryAsJsonOpts{
+		RecordApiPagingOpts: client.RecordApiPagingOpts{LastToFirst: optional.NewBool(true)},
+	})
+	require.Nil(t, err)
+	require.Len(t, queryJson.Items, 3)
+	assert.Equal(t, int64(2), queryJson.Items[0].Serial)
+}
+
+func TestJsonDocumentApiService(t *testing.T) {
+	node, c := newTestNode(t)
+	ctx := context.Background()
+	chain := node.CreateChain("chain")
+
+	doc, _, err := c.JsonDocumentApi.JsonDocumentsAdd(ctx, chain, map[string]any{"a": 1})
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), doc.Serial)
+	_, _, err = c.JsonDocumentApi.JsonDocumentsAddWithChainKeys(ctx, chain, []string{"chain"}, map[string]any{"b": 2})
+	require.Nil(t, err)
+	_, _, err = c.JsonDocumentApi.JsonDocumentsAddWithIndirectKeys(ctx, chain, []string{"ref"}, map[string]any{"c": 3})
+	require.Nil(t, err)
+	_, _, err = c.JsonDocumentApi.JsonDocumentsAddWithKey(ctx, chain, "PubKey!key", "Key!id", map[string]any{"d": 4})
+	require.Nil(t, err)
+	ref, _, err := c.JsonDocumentApi.JsonDocumentsAllowReaders(ctx, chain, &models.AllowedReadersModel{
+		ContextId: "context",
+		Readers:   []models.ReaderModel{{Name: "reader", PublicKey: "PubKey!key"}},
+	})
+	require.Nil(t, err)
+	assert.Contains(t, ref, chain+"@5")
+
+	got, _, err := c.JsonDocumentApi.JsonDocumentsGet(ctx, chain, 1)
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"a":1}`, got.JsonText)
+}
+
+func TestDocumentsApiService(t *testing.T) {
+	node, c := newTestNode(t)
+	ctx := context.Background()
+	chain := node.CreateChain("chain")
+
+	config, _, err := c.DocumentsApi.DocumentsGetConfig(ctx)
+	require.Nil(t, err)
+	assert.Contains(t, config.PermittedContentTypes, "text/plain")
+
+	tx, _, err := c.DocumentsApi.DocumentsBeginTransaction(ctx, &models.DocumentsBeginTransactionModel{
+		Chain: chain, Comment: "comment"})
+	require.Nil(t, err)
+	require.NotEmpty(t, tx.TransactionId)
+
+	for _, name := range []string{"a.txt", "b.txt"} {
+		_, _, err = c.DocumentsApi.DocumentsAddDocument(ctx, tx.TransactionId,
+			&client.DocumentsApiDocumentsAddDocumentParams{
+				Name:        name,
+				ContentType: "text/plain",
+				Contents:    strings.NewReader("contents of " + name),
+			})
+		require.Nil(t, err)
+	}
+	status, _, err := c.DocumentsApi.DocumentsGetTransactionStatus(ctx, tx.TransactionId)
+	require.Nil(t, err)
+	assert.Equal(t, int32(2), status.CountOfUploadedDocuments)
+	assert.True(t, status.CanCommitNow)
+
+	locator, _, err := c.DocumentsApi.DocumentsCommitTransaction(ctx, tx.TransactionId)
+	require.Nil(t, err)
+	require.NotEmpty(t, locator)
+
+	metadata, _, err := c.DocumentsApi.DocumentsGetMetadata(ctx, locator)
+	require.Nil(t, err)
+	assert.Equal(t, "comment", metadata.Comment)
+	require.Len(t, metadata.PublicDirectory, 2)
+
+	resp, err := c.DocumentsApi.DocumentsGetSingleDocument(ctx, locator, 1)
+	require.Nil(t, err)
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.Nil(t, err)
+	assert.Equal(t, "contents of b.txt", string(b))
+
+	resp, err = c.DocumentsApi.DocumentsGetAllDocuments(ctx, locator)
+	require.Nil(t, err)
+	b, err = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.Nil(t, err)
+	z, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	require.Nil(t, err)
+	assert.Len(t, z.File, 2)
+}
+
+func TestOpaqueService(t *testing.T) {
+	node, c := newTestNode(t)
+	ctx := context.Background()
+	chain := node.CreateChain("chain")
+
+	rec, _, err := c.OpaqueApi.Create(ctx, chain, 10, 20, bytes.NewReader([]byte{1, 2, 3}), 0)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), rec.Serial)
+
+	// Optimistic lock
+	_, _, err = c.OpaqueApi.Create(ctx, chain, 10, 20, bytes.NewReader([]byte{4}), 5)
+	assert.ErrorIs(t, err, client.ErrOptimisticLockError)
+	assert.ErrorIs(t, err, client.ErrConflict)
+	var apiErr *client.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 409, apiErr.StatusCode)
+	assert.Equal(t, "Opaque_Create", apiErr.Operation)
+	assert.Equal(t, "the last changed record is 1", apiErr.Detail)
+	_, _, err = c.OpaqueApi.Create(ctx, chain, 10, 20, bytes.NewReader([]byte{4}), 1)
+	require.Nil(t, err)
+
+	payload, appId, typeId, _, err := c.OpaqueApi.Get(ctx, chain, 1)
+	require.Nil(t, err)
+	assert.Equal(t, []byte{1, 2, 3}, payload)
+	assert.Equal(t, int64(10), appId)
+	assert.Equal(t, int64(20), typeId)
+
+	page, _, err := c.OpaqueApi.QueryJson(ctx, chain, 10, []int64{20}, 0, true, 0, 0)
+	require.Nil(t, err)
+	require.Len(t, page.Items, 2)
+	assert.Equal(t, int64(2), page.Items[0].Serial)
+	assert.Equal(t, int64(2), page.LastChangedRecordSerial)
+}
@@ -0,0 +1,304 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antihax/optional"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+)
+
+/*
+Page size used by the snapshot pagers when none is given. It is the same
+default page size used by the node.
+*/
+const DefaultSnapshotPageSize = 10
+
+/*
+Serial range of a chain pinned at the first use. It translates page numbers
+into stable serial ranges.
+*/
+type recordSnapshot struct {
+	chainApi    ChainAPI
+	chain       string
+	first       int64
+	last        optional.Int64
+	pageSize    int64
+	lastToFirst bool
+	pinned      bool
+	top         int64
+}
+
+func newRecordSnapshot(chainApi ChainAPI, chain string, paging RecordApiPagingOpts,
+	first, last optional.Int64) *recordSnapshot {
+	s := &recordSnapshot{
+		chainApi:    chainApi,
+		chain:       chain,
+		first:       first.Value(),
+		last:        last,
+		pageSize:    int64(paging.PageSize.Value()),
+		lastToFirst: paging.LastToFirst.Value(),
+	}
+	if s.first < 0 {
+		s.first = 0
+	}
+	if s.pageSize <= 0 {
+		s.pageSize = DefaultSnapshotPageSize
+	}
+	return s
+}
+
+/*
+Returns the serial range of the given page and the total number of pages. The
+upper bound is pinned at the first call.
+*/
+func (s *recordSnapshot) window(ctx context.Context, page int32) (int64, int64, int32, error) {
+	if !s.pinned {
+		details, _, err := s.chainApi.ChainDetails(ctx, s.chain)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		s.top = details.LastRecord
+		if s.last.IsSet() && s.last.Value() < s.top {
+			s.top = s.last.Value()
+		}
+		s.pinned = true
+	}
+	count := s.top - s.first + 1
+	if count <= 0 {
+		return 0, 0, 0, nil
+	}
+	total := int32((count + s.pageSize - 1) / s.pageSize)
+	if page >= total {
+		return 0, 0, total, nil
+	}
+	var first, last int64
+	if s.lastToFirst {
+		last = s.top - int64(page)*s.pageSize
+		first = last - s.pageSize + 1
+		if first < s.first {
+			first = s.first
+		}
+	} else {
+		first = s.first + int64(page)*s.pageSize
+		last = first + s.pageSize - 1
+		if last > s.top {
+			last = s.top
+		}
+	}
+	return first, last, total, nil
+}
+
+// Returns the options used to fetch the given window.
+func (s *recordSnapshot) windowOpts(first, last int64) RecordApiRecordsListOpts {
+	return RecordApiRecordsListOpts{
+		RecordApiPagingOpts: RecordApiPagingOpts{
+			PageSize:    optional.NewInt32(int32(s.pageSize)),
+			LastToFirst: optional.NewBool(s.lastToFirst),
+		},
+		FirstSerial: optional.NewInt64(first),
+		LastSerial:  optional.NewInt64(last),
+	}
+}
+
+// Fetches all items of a pager.
+func collectPager[T any](p *Pager[T]) ([]T, error) {
+	var ret []T
+	for p.Next() {
+		ret = append(ret, p.Item())
+	}
+	return ret, p.Err()
+}
+
+/*
+Creates a Pager over RecordApi.RecordsList() that traverses a consistent
+snapshot of the chain. The last record of the chain is captured when the first
+page is fetched and each page is translated into a fixed serial range, thus
+records added during the traversal neither shift the pages nor appear in the
+results, regardless of the direction.
+
+The page size, direction, serial range and first page are taken from
+options, which may be nil. If the page size is not set,
+DefaultSnapshotPageSize is used.
+*/
+func NewSnapshotRecordsListPager(ctx context.Context, chainApi ChainAPI, recordApi RecordAPI, chain string,
+	options *RecordApiRecordsListOpts) *Pager[models.RecordModel] {
+	var opts RecordApiRecordsListOpts
+	if options != nil {
+		opts = *options
+	}
+	s := newRecordSnapshot(chainApi, chain, opts.RecordApiPagingOpts, opts.FirstSerial, opts.LastSerial)
+	return NewPager(ctx, opts.Page.Value(), func(ctx context.Context, page int32) ([]models.RecordModel, int32, error) {
+		first, last, total, err := s.window(ctx, page)
+		if err != nil || page >= total {
+			return nil, total, err
+		}
+		windowOpts := s.windowOpts(first, last)
+		items, err := collectPager(NewRecordsListPager(ctx, recordApi, chain, &windowOpts))
+		return items, total, err
+	})
+}
+
+/*
+Creates a Pager over RecordApi.RecordsListAsJson() that traverses a consistent
+snapshot of the chain. See NewSnapshotRecordsListPager() for details.
+*/
+func NewSnapshotRecordsListAsJsonPager(ctx context.Context, chainApi ChainAPI, recordApi RecordAPI, chain string,
+	options *RecordApiRecordsListAsJsonOpts) *Pager[models.RecordModelAsJson] {
+	var opts RecordApiRecordsListAsJsonOpts
+	if options != nil {
+		opts = *options
+	}
+	s := newRecordSnapshot(chainApi, chain, opts.RecordApiPagingOpts, opts.FirstSerial, opts.LastSerial)
+	return NewPager(ctx, opts.Page.Value(), func(ctx context.Context, page int32) ([]models.RecordModelAsJson, int32, error) {
+		first, last, total, err := s.window(ctx, page)
+		if err != nil || page >= total {
+			return nil, total, err
+		}
+		windowOpts := s.windowOpts(first, last)
+		jsonOpts := RecordApiRecordsListAsJsonOpts(windowOpts)
+		items, err := collectPager(NewRecordsListAsJsonPager(ctx, recordApi, chain, &jsonOpts))
+		return items, total, err
+	})
+}
+
+/*
+Wraps the fetcher of an interlocking list, removing the items added after the
+snapshot and the items that were already returned because the page boundaries
+shifted. pin is called once, before the first page, and returns the function
+that tells if an item belongs to the snapshot.
+*/
+func snapshotInterlockings(pin func(ctx context.Context) (func(models.InterlockingRecordModel) bool, error),
+	list func(ctx context.Context, page int32) (models.InterlockingRecordModelPageOf, error)) PageFetcher[models.InterlockingRecordModel] {
+	var (
+		inSnapshot func(models.InterlockingRecordModel) bool
+		seen       = make(map[string]bool)
+		skipped    int32
+	)
+	return func(ctx context.Context, page int32) ([]models.InterlockingRecordModel, int32, error) {
+		if inSnapshot == nil {
+			f, err := pin(ctx)
+			if err != nil {
+				return nil, 0, err
+			}
+			inSnapshot = f
+		}
+		for {
+			ret, err := list(ctx, page+skipped)
+			if err != nil {
+				return nil, 0, err
+			}
+			var items []models.InterlockingRecordModel
+			for _, item := range ret.Items {
+				key := fmt.Sprintf("%s@%d", item.ChainId, item.Serial)
+				if !seen[key] && inSnapshot(item) {
+					seen[key] = true
+					items = append(items, item)
+				}
+			}
+			total := ret.TotalNumberOfPages - skipped
+			// A page may be entirely filtered out, try the next one.
+			if len(items) > 0 || len(ret.Items) == 0 || page+1 >= total {
+				return items, total, nil
+			}
+			skipped++
+		}
+	}
+}
+
+/*
+Creates a Pager over ChainApi.ChainInterlockingsList() that traverses a
+consistent snapshot of the interlockings of the chain. As this endpoint does
+not accept serial ranges, the last record of the chain is captured when the
+first page is fetched and the interlockings recorded after it are removed, as
+well as the interlockings repeated because the page boundaries shifted.
+
+The page size, HowManyFromLast and first page are taken from options, which
+may be nil.
+*/
+func NewSnapshotChainInterlockingsPager(ctx context.Context, api ChainAPI, chain string,
+	options *ChainApiChainInterlockingsListOpts) *Pager[models.InterlockingRecordModel] {
+	var opts ChainApiChainInterlockingsListOpts
+	if options != nil {
+		opts = *options
+	}
+	pin := func(ctx context.Context) (func(models.InterlockingRecordModel) bool, error) {
+		details, _, err := api.ChainDetails(ctx, chain)
+		if err != nil {
+			return nil, err
+		}
+		return func(item models.InterlockingRecordModel) bool {
+			return item.Serial <= details.LastRecord
+		}, nil
+	}
+	list := func(ctx context.Context, page int32) (models.InterlockingRecordModelPageOf, error) {
+		opts.Page = optional.NewInt32(page)
+		ret, _, err := api.ChainInterlockingsList(ctx, chain, &opts)
+		return ret, err
+	}
+	return NewPager(ctx, opts.Page.Value(), snapshotInterlockings(pin, list))
+}
+
+/*
+Creates a Pager over NodeApi.InterlockingsList() that traverses a consistent
+snapshot of the interlockings of the target chain. The last record of the
+target chain is captured when the first page is fetched and the interlockings
+of later records are removed, as well as the interlockings repeated because
+the page boundaries shifted. Interlockings of the captured last record that
+are created during the traversal may still be returned.
+
+The page size, direction, LastKnownBlock and first page are taken from
+options, which may be nil.
+*/
+func NewSnapshotInterlockingsPager(ctx context.Context, chainApi ChainAPI, nodeApi NodeAPI, targetChain string,
+	options *NodeApiInterlockingsListOpts) *Pager[models.InterlockingRecordModel] {
+	var opts NodeApiInterlockingsListOpts
+	if options != nil {
+		opts = *options
+	}
+	pin := func(ctx context.Context) (func(models.InterlockingRecordModel) bool, error) {
+		details, _, err := chainApi.ChainDetails(ctx, targetChain)
+		if err != nil {
+			return nil, err
+		}
+		return func(item models.InterlockingRecordModel) bool {
+			return item.InterlockedRecordSerial <= details.LastRecord
+		}, nil
+	}
+	list := func(ctx context.Context, page int32) (models.InterlockingRecordModelPageOf, error) {
+		opts.Page = optional.NewInt32(page)
+		ret, _, err := nodeApi.InterlockingsList(ctx, targetChain, &opts)
+		return ret, err
+	}
+	return NewPager(ctx, opts.Page.Value(), snapshotInterlockings(pin, list))
+}
@@ -0,0 +1,173 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/antihax/optional"
+	"github.com/interlockledger/go-interlockledger-rest-client/client"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/clienttest"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotRecordPagers(t *testing.T) {
+	node, c, chain := newExportNode(t, 25)
+	ctx := context.Background()
+	// Each list call appends a new record to the chain
+	node.SetErrorHook(func(operation string, request *http.Request) int {
+		if operation == "Records_List" || operation == "Records_List_AsJson" {
+			node.AddRecord(chain, 1, []byte{0xF8, 0x34, 0x00})
+		}
+		return 0
+	})
+	lastToFirst := client.RecordApiPagingOpts{
+		PageSize:    optional.NewInt32(4),
+		LastToFirst: optional.NewBool(true),
+	}
+
+	// The plain pager sees the chain moving
+	s := serials(t, client.NewRecordsListPager(ctx, c.RecordApi, chain,
+		&client.RecordApiRecordsListOpts{RecordApiPagingOpts: lastToFirst}), recordSerial)
+	assert.NotEqual(t, reversedRange(0, 24), s)
+
+	// Snapshots do not
+	last := int64(len(node.Records(chain)) - 1)
+	s = serials(t, client.NewSnapshotRecordsListPager(ctx, c.ChainApi, c.RecordApi, chain,
+		&client.RecordApiRecordsListOpts{RecordApiPagingOpts: lastToFirst}), recordSerial)
+	assert.Equal(t, reversedRange(0, last), s)
+
+	last = int64(len(node.Records(chain)) - 1)
+	s = serials(t, client.NewSnapshotRecordsListPager(ctx, c.ChainApi, c.RecordApi, chain, nil), recordSerial)
+	assert.Equal(t, serialRange(0, last), s)
+
+	s = serials(t, client.NewSnapshotRecordsListAsJsonPager(ctx, c.ChainApi, c.RecordApi, chain,
+		&client.RecordApiRecordsListAsJsonOpts{
+			RecordApiPagingOpts: lastToFirst,
+			FirstSerial:         optional.NewInt64(3),
+			LastSerial:          optional.NewInt64(12),
+		}), jsonRecordSerial)
+	assert.Equal(t, reversedRange(3, 12), s)
+
+	s = serials(t, client.NewSnapshotRecordsListAsJsonPager(ctx, c.ChainApi, c.RecordApi, chain,
+		&client.RecordApiRecordsListAsJsonOpts{
+			RecordApiPagingOpts: client.RecordApiPagingOpts{
+				PageSize: optional.NewInt32(3),
+				Page:     optional.NewInt32(2),
+			},
+			LastSerial: optional.NewInt64(10),
+		}), jsonRecordSerial)
+	assert.Equal(t, serialRange(6, 10), s)
+
+	// Empty range
+	s = serials(t, client.NewSnapshotRecordsListPager(ctx, c.ChainApi, c.RecordApi, chain,
+		&client.RecordApiRecordsListOpts{FirstSerial: optional.NewInt64(1000)}), recordSerial)
+	assert.Empty(t, s)
+
+	node.FailNext("Chain_Details", http.StatusNotFound, 1)
+	p := client.NewSnapshotRecordsListPager(ctx, c.ChainApi, c.RecordApi, chain, nil)
+	assert.False(t, p.Next())
+	assert.ErrorIs(t, p.Err(), client.ErrNotFound)
+}
+
+func reversedRange(first, last int64) []int64 {
+	var ret []int64
+	for s := last; s >= first; s-- {
+		ret = append(ret, s)
+	}
+	return ret
+}
+
+func TestNewSnapshotChainInterlockingsPager(t *testing.T) {
+	// Newest first, with new items added after the first page
+	items := []int64{5, 4, 3, 2, 1}
+	m := &clienttest.MockChainAPI{}
+	m.ChainDetailsFunc = func(ctx context.Context, chain string) (models.ChainSummaryModel, *http.Response, error) {
+		return models.ChainSummaryModel{LastRecord: items[0]}, nil, nil
+	}
+	m.ChainInterlockingsListFunc = func(ctx context.Context, chain string,
+		params *client.ChainApiChainInterlockingsListOpts) (models.InterlockingRecordModelPageOf, *http.Response, error) {
+		page, size := params.Page.Value(), params.PageSize.Value()
+		var ret models.InterlockingRecordModelPageOf
+		for i := page * size; i < (page+1)*size && int(i) < len(items); i++ {
+			ret.Items = append(ret.Items, models.InterlockingRecordModel{ChainId: chain, Serial: items[i]})
+		}
+		ret.TotalNumberOfPages = (int32(len(items)) + size - 1) / size
+		if page == 0 {
+			items = append([]int64{8, 7, 6}, items...)
+		}
+		return ret, nil, nil
+	}
+
+	p := client.NewSnapshotChainInterlockingsPager(context.Background(), m, "chain",
+		&client.ChainApiChainInterlockingsListOpts{PageSize: optional.NewInt32(2)})
+	assert.Equal(t, []int64{5, 4, 3, 2, 1}, serials(t, p, interlockingSerial))
+	assert.Len(t, m.CallsTo("ChainDetails"), 1)
+	// The page [6, 5] is skipped
+	assert.Len(t, m.CallsTo("ChainInterlockingsList"), 4)
+}
+
+func TestNewSnapshotInterlockingsPager(t *testing.T) {
+	node := clienttest.NewNode(nil)
+	defer node.Close()
+	c := node.NewClient()
+	ctx := context.Background()
+	source := node.CreateChain("source")
+	target := node.CreateChain("target")
+	for i := 0; i < 7; i++ {
+		node.AddRecord(target, 1, []byte{0xF8, 0x34, 0x00})
+		_, _, err := c.ChainApi.ChainInterlockingAdd(ctx, source, &models.ForceInterlockModel{TargetChain: target})
+		require.Nil(t, err)
+	}
+	node.SetErrorHook(func(operation string, request *http.Request) int {
+		if operation == "Interlockings_List" {
+			node.AddRecord(target, 1, []byte{0xF8, 0x34, 0x00})
+			_, _, err := c.ChainApi.ChainInterlockingAdd(ctx, source, &models.ForceInterlockModel{TargetChain: target})
+			require.Nil(t, err)
+		}
+		return 0
+	})
+
+	p := client.NewSnapshotInterlockingsPager(ctx, c.ChainApi, c.NodeApi, target, &client.NodeApiInterlockingsListOpts{
+		PageSize:    optional.NewInt32(2),
+		LastToFirst: optional.NewBool(true),
+	})
+	var interlocked []int64
+	for item, err := range p.All() {
+		require.Nil(t, err)
+		interlocked = append(interlocked, item.InterlockedRecordSerial)
+	}
+	assert.Equal(t, reversedRange(1, 7), interlocked)
+}
@@ -0,0 +1,263 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+)
+
+/*
+A JSON record whose payload is decoded into T. It holds the same metadata of
+models.RecordModelAsJson.
+*/
+type TypedRecord[T any] struct {
+	ApplicationId int64 `json:"applicationId,omitempty"`
+	// Chain unique ID
+	ChainId   string    `json:"chainId,omitempty"`
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+	Hash      string    `json:"hash,omitempty"`
+	// Name of the network
+	Network      string `json:"network,omitempty"`
+	PayloadTagId int64  `json:"payloadTagId,omitempty"`
+	// A universal record reference in the form networkName:chainId@recordSerial
+	Reference string             `json:"reference,omitempty"`
+	Serial    int64              `json:"serial,omitempty"`
+	Type_     *models.RecordType `json:"type,omitempty"`
+	Version   int32              `json:"version,omitempty"`
+	// The payload
+	Payload T `json:"payload"`
+}
+
+/*
+A page of typed JSON records.
+*/
+type TypedRecordPageOf[T any] struct {
+	Items              []TypedRecord[T] `json:"items,omitempty"`
+	Page               int32            `json:"page,omitempty"`
+	PageSize           int32            `json:"pageSize,omitempty"`
+	TotalNumberOfPages int32            `json:"totalNumberOfPages,omitempty"`
+	LastToFirst        bool             `json:"lastToFirst,omitempty"`
+}
+
+/*
+Options that control how the JSON payloads are decoded into typed records. A
+nil value uses the same rules of encoding/json.Unmarshal().
+*/
+type TypedRecordOpts struct {
+	// Fails if the payload has properties that do not match the fields of T.
+	DisallowUnknownFields bool
+	// Decodes the numbers stored in interface values as json.Number instead
+	// of float64.
+	UseNumber bool
+	// Fails with ErrMissingPayload if the record has no payload, instead of
+	// leaving it as the zero value of T.
+	RequirePayload bool
+}
+
+// JSON record as returned by the node, with the payload not yet decoded.
+type rawJSONRecord struct {
+	ApplicationId int64              `json:"applicationId,omitempty"`
+	ChainId       string             `json:"chainId,omitempty"`
+	CreatedAt     time.Time          `json:"createdAt,omitempty"`
+	Hash          string             `json:"hash,omitempty"`
+	Network       *models.NetworkId  `json:"network,omitempty"`
+	PayloadTagId  int64              `json:"payloadTagId,omitempty"`
+	Reference     string             `json:"reference,omitempty"`
+	Serial        int64              `json:"serial,omitempty"`
+	Type_         *models.RecordType `json:"type,omitempty"`
+	Version       int32              `json:"version,omitempty"`
+	Payload       json.RawMessage    `json:"payload,omitempty"`
+}
+
+// Page of JSON records as returned by the node.
+type rawJSONRecordPageOf struct {
+	Items              []rawJSONRecord `json:"items,omitempty"`
+	Page               int32           `json:"page,omitempty"`
+	PageSize           int32           `json:"pageSize,omitempty"`
+	TotalNumberOfPages int32           `json:"totalNumberOfPages,omitempty"`
+	LastToFirst        bool            `json:"lastToFirst,omitempty"`
+}
+
+// Decodes the payload of the record into T.
+func decodeTypedRecord[T any](r *rawJSONRecord, opts *TypedRecordOpts) (TypedRecord[T], error) {
+	ret := TypedRecord[T]{
+		ApplicationId: r.ApplicationId,
+		ChainId:       r.ChainId,
+		CreatedAt:     r.CreatedAt,
+		Hash:          r.Hash,
+		PayloadTagId:  r.PayloadTagId,
+		Reference:     r.Reference,
+		Serial:        r.Serial,
+		Type_:         r.Type_,
+		Version:       r.Version,
+	}
+	if r.Network != nil {
+		ret.Network = r.Network.Name
+	}
+	if opts == nil {
+		opts = &TypedRecordOpts{}
+	}
+	if len(r.Payload) == 0 || string(r.Payload) == "null" {
+		if opts.RequirePayload {
+			return ret, fmt.Errorf("record %d: %w", r.Serial, ErrMissingPayload)
+		}
+		return ret, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(r.Payload))
+	if opts.DisallowUnknownFields {
+		dec.DisallowUnknownFields()
+	}
+	if opts.UseNumber {
+		dec.UseNumber()
+	}
+	if err := dec.Decode(&ret.Payload); err != nil {
+		return ret, fmt.Errorf("unable to decode the payload of the record %d: %w", r.Serial, err)
+	}
+	return ret, nil
+}
+
+/*
+Adds a new JSON record whose payload is v. The payload is marshaled directly
+from v. The returned record holds the metadata returned by the node and v
+as its payload.
+*/
+func AddAsJSON[T any](ctx context.Context, c Client, chain string, options *RecordApiRecordAddAsJsonOpts,
+	v T) (TypedRecord[T], *http.Response, error) {
+
+	rec, resp, err := c.Record().RecordAddAsJson(ctx, chain, options, v)
+	if err != nil {
+		return TypedRecord[T]{}, resp, err
+	}
+	return TypedRecord[T]{
+		ApplicationId: rec.ApplicationId,
+		ChainId:       rec.ChainId,
+		CreatedAt:     rec.CreatedAt,
+		Hash:          rec.Hash,
+		Network:       rec.Network,
+		PayloadTagId:  rec.PayloadTagId,
+		Reference:     rec.Reference,
+		Serial:        rec.Serial,
+		Type_:         rec.Type_,
+		Version:       rec.Version,
+		Payload:       v,
+	}, resp, nil
+}
+
+/*
+Implemented by RecordApiService to decode the JSON records into any value, thus
+the payloads can be decoded straight into T.
+*/
+type jsonRecordDecoder interface {
+	recordGetAsJson(ctx context.Context, chain string, serial int64, out interface{}) (*http.Response, error)
+	recordsQueryAsJson(ctx context.Context, chain string, options *RecordApiRecordsQueryAsJsonOpts,
+		out interface{}) (*http.Response, error)
+}
+
+// Converts the generic value v into out by encoding it as JSON.
+func convertJSON(v any, out any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
+
+/*
+Gets a JSON record and decodes its payload straight into T, as
+RecordApi.RecordGetAsJson() does with a generic value. opts may be nil.
+
+If c.Record() is not a RecordApiService, such as the mocks of clienttest, the
+record is fetched by RecordGetAsJson() and its payload is converted into T.
+*/
+func GetAsJSON[T any](ctx context.Context, c Client, chain string, serial int64,
+	opts *TypedRecordOpts) (TypedRecord[T], *http.Response, error) {
+
+	var rec rawJSONRecord
+	var resp *http.Response
+	var err error
+	if d, ok := c.Record().(jsonRecordDecoder); ok {
+		resp, err = d.recordGetAsJson(ctx, chain, serial, &rec)
+	} else {
+		var generic models.RecordModelAsJson
+		if generic, resp, err = c.Record().RecordGetAsJson(ctx, chain, serial); err == nil {
+			err = convertJSON(generic, &rec)
+		}
+	}
+	if err != nil {
+		return TypedRecord[T]{}, resp, err
+	}
+	ret, err := decodeTypedRecord[T](&rec, opts)
+	return ret, resp, err
+}
+
+/*
+Queries the JSON records of a chain and decodes their payloads straight into
+T, as RecordApi.RecordsQueryAsJson() does with generic values. Both options
+and opts may be nil. See GetAsJSON() for details.
+*/
+func QueryAsJSON[T any](ctx context.Context, c Client, chain string, options *RecordApiRecordsQueryAsJsonOpts,
+	opts *TypedRecordOpts) (TypedRecordPageOf[T], *http.Response, error) {
+
+	var page rawJSONRecordPageOf
+	var resp *http.Response
+	var err error
+	if d, ok := c.Record().(jsonRecordDecoder); ok {
+		resp, err = d.recordsQueryAsJson(ctx, chain, options, &page)
+	} else {
+		var generic models.RecordModelAsJsonPageOf
+		if generic, resp, err = c.Record().RecordsQueryAsJson(ctx, chain, options); err == nil {
+			err = convertJSON(generic, &page)
+		}
+	}
+	if err != nil {
+		return TypedRecordPageOf[T]{}, resp, err
+	}
+	ret := TypedRecordPageOf[T]{
+		Items:              make([]TypedRecord[T], len(page.Items)),
+		Page:               page.Page,
+		PageSize:           page.PageSize,
+		TotalNumberOfPages: page.TotalNumberOfPages,
+		LastToFirst:        page.LastToFirst,
+	}
+	for i := range page.Items {
+		if ret.Items[i], err = decodeTypedRecord[T](&page.Items[i], opts); err != nil {
+			return TypedRecordPageOf[T]{}, resp, err
+		}
+	}
+	return ret, resp, nil
+}
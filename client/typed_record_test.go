@@ -0,0 +1,167 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/antihax/optional"
+	"github.com/interlockledger/go-interlockledger-rest-client/client"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/clienttest"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type typedPayload struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func TestTypedRecords(t *testing.T) {
+	node, c := newTestNode(t)
+	ctx := context.Background()
+	chain := node.CreateChain("chain")
+
+	options := &client.RecordApiRecordAddAsJsonOpts{
+		ApplicationId: optional.NewInt64(8),
+		PayloadTagId:  optional.NewInt64(1000),
+	}
+	added, _, err := client.AddAsJSON(ctx, c, chain, options, typedPayload{Name: "a", Count: 1})
+	require.Nil(t, err)
+	assert.Equal(t, int64(8), added.ApplicationId)
+	assert.Equal(t, chain, added.ChainId)
+	assert.NotEmpty(t, added.Reference)
+	assert.NotEmpty(t, added.Hash)
+	assert.Equal(t, typedPayload{Name: "a", Count: 1}, added.Payload)
+	_, _, err = client.AddAsJSON(ctx, c, chain, options, typedPayload{Name: "b", Count: 2})
+	require.Nil(t, err)
+	_, err = node.AddJSONRecord(chain, 8, 1000, map[string]any{"name": "c", "count": 3, "extra": true})
+	require.Nil(t, err)
+
+	rec, _, err := client.GetAsJSON[typedPayload](ctx, c, chain, added.Serial, nil)
+	require.Nil(t, err)
+	assert.Equal(t, added.Serial, rec.Serial)
+	assert.Equal(t, added.Reference, rec.Reference)
+	assert.Equal(t, added.Hash, rec.Hash)
+	assert.True(t, added.CreatedAt.Equal(rec.CreatedAt))
+	assert.Equal(t, typedPayload{Name: "a", Count: 1}, rec.Payload)
+
+	recGeneric, _, err := client.GetAsJSON[map[string]any](ctx, c, chain, added.Serial,
+		&client.TypedRecordOpts{UseNumber: true})
+	require.Nil(t, err)
+	assert.Equal(t, json.Number("1"), recGeneric.Payload["count"])
+
+	page, _, err := client.QueryAsJSON[typedPayload](ctx, c, chain, &client.RecordApiRecordsQueryAsJsonOpts{
+		RecordApiPagingOpts: client.RecordApiPagingOpts{LastToFirst: optional.NewBool(true)},
+		HowMany:             optional.NewInt64(3)}, nil)
+	require.Nil(t, err)
+	require.Len(t, page.Items, 3)
+	assert.True(t, page.LastToFirst)
+	assert.Equal(t, []typedPayload{{"c", 3}, {"b", 2}, {"a", 1}},
+		[]typedPayload{page.Items[0].Payload, page.Items[1].Payload, page.Items[2].Payload})
+
+	// Strict decoding
+	_, _, err = client.QueryAsJSON[typedPayload](ctx, c, chain, &client.RecordApiRecordsQueryAsJsonOpts{
+		RecordApiPagingOpts: client.RecordApiPagingOpts{LastToFirst: optional.NewBool(true)},
+		HowMany:             optional.NewInt64(3)}, &client.TypedRecordOpts{DisallowUnknownFields: true})
+	assert.ErrorContains(t, err, `unknown field "extra"`)
+
+	_, _, err = client.GetAsJSON[typedPayload](ctx, c, chain, 1000, nil)
+	assert.ErrorIs(t, err, client.ErrNotFound)
+
+	// The operation names and the cache are the ones of the RecordApi
+	var operations []string
+	c.Use(func(next client.Handler) client.Handler {
+		return func(operation string, request *http.Request) (*http.Response, error) {
+			operations = append(operations, operation)
+			return next(operation, request)
+		}
+	})
+	c.SetCache(client.NewLRUCache(10))
+	for i := 0; i < 2; i++ {
+		_, _, err = client.GetAsJSON[typedPayload](ctx, c, chain, added.Serial, nil)
+		require.Nil(t, err)
+	}
+	_, _, err = client.QueryAsJSON[typedPayload](ctx, c, chain, &client.RecordApiRecordsQueryAsJsonOpts{
+		RecordApiPagingOpts: client.RecordApiPagingOpts{LastToFirst: optional.NewBool(true)},
+		HowMany:             optional.NewInt64(1)}, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"Record_Get_AsJson", "Records_Query_AsJson"}, operations)
+}
+
+func TestTypedRecordsMock(t *testing.T) {
+	ctx := context.Background()
+	var c clienttest.MockClient
+	var payload models.Object
+	c.RecordAPI.RecordGetAsJsonFunc = func(ctx context.Context, chain string, serial int64) (models.RecordModelAsJson, *http.Response, error) {
+		return models.RecordModelAsJson{Serial: serial, Network: &models.NetworkId{Name: "net"}, Payload: &payload}, nil, nil
+	}
+	rec, _, err := client.GetAsJSON[typedPayload](ctx, &c, "chain", 3, nil)
+	require.Nil(t, err)
+	assert.Equal(t, int64(3), rec.Serial)
+	assert.Equal(t, "net", rec.Network)
+	assert.Equal(t, typedPayload{}, rec.Payload)
+	assert.Equal(t, []clienttest.Call{{Method: "RecordGetAsJson", Args: []any{"chain", int64(3)}}},
+		c.RecordAPI.Calls())
+
+	_, _, err = client.GetAsJSON[typedPayload](ctx, &c, "chain", 3, &client.TypedRecordOpts{RequirePayload: true})
+	assert.ErrorIs(t, err, client.ErrMissingPayload)
+
+	payload = map[string]any{"count": "x"}
+	_, _, err = client.GetAsJSON[typedPayload](ctx, &c, "chain", 3, nil)
+	var typeErr *json.UnmarshalTypeError
+	assert.True(t, errors.As(err, &typeErr))
+
+	payload = map[string]any{"name": "a", "count": 1}
+	c.RecordAPI.RecordsQueryAsJsonFunc = func(ctx context.Context, chain string,
+		options *client.RecordApiRecordsQueryAsJsonOpts) (models.RecordModelAsJsonPageOf, *http.Response, error) {
+		return models.RecordModelAsJsonPageOf{Items: []models.RecordModelAsJson{{Serial: 1, Payload: &payload}},
+			TotalNumberOfPages: 1}, nil, nil
+	}
+	page, _, err := client.QueryAsJSON[typedPayload](ctx, &c, "chain", nil, nil)
+	require.Nil(t, err)
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, typedPayload{"a", 1}, page.Items[0].Payload)
+	assert.Len(t, c.RecordAPI.CallsTo("RecordsQueryAsJson"), 1)
+	assert.Empty(t, c.Calls())
+
+	c.RecordAPI.RecordAddAsJsonFunc = func(ctx context.Context, chain string, options *client.RecordApiRecordAddAsJsonOpts,
+		jsonPayload interface{}) (models.RecordModel, *http.Response, error) {
+		return models.RecordModel{}, nil, client.ErrValidation
+	}
+	_, _, err = client.AddAsJSON(ctx, &c, "chain", nil, typedPayload{})
+	assert.ErrorIs(t, err, client.ErrValidation)
+}
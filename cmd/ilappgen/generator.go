@@ -0,0 +1,441 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/interlockledger/go-iltags/tags"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+)
+
+// Generates the Go code of the data models of the applications.
+type generator struct {
+	buf      bytes.Buffer
+	names    map[string]bool
+	needTime bool
+	needBig  bool
+	// Pending struct types of sub fields.
+	pending []pendingStruct
+}
+
+// Struct type of a field with sub fields that still needs to be generated.
+type pendingStruct struct {
+	name   string
+	doc    string
+	fields []models.DataField
+}
+
+/*
+Generates the source of a Go file with the types of the payloads of the given
+applications. If appIds is not empty, only the listed applications are
+generated.
+*/
+func generate(apps *models.AppsModel, pkg string, appIds []int64) ([]byte, error) {
+	g := &generator{names: make(map[string]bool)}
+	found := false
+	for i := range apps.ValidApps {
+		app := &apps.ValidApps[i]
+		if len(appIds) > 0 && !containsId(appIds, app.Id) {
+			continue
+		}
+		found = true
+		if err := g.generateApp(app); err != nil {
+			return nil, err
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("no application to generate")
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by ilappgen. DO NOT EDIT.\n\n")
+	if apps.Network != "" {
+		fmt.Fprintf(&out, "// Data models of the network %s.\n\n", apps.Network)
+	}
+	fmt.Fprintf(&out, "package %s\n\nimport (\n\t\"encoding/json\"\n", pkg)
+	if g.needBig {
+		fmt.Fprintf(&out, "\t\"math/big\"\n")
+	}
+	if g.needTime {
+		fmt.Fprintf(&out, "\t\"time\"\n")
+	}
+	fmt.Fprintf(&out, "\n\t\"github.com/interlockledger/go-interlockledger-rest-client/client/models\"\n)\n")
+	out.Write(g.buf.Bytes())
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("unable to format the generated code: %w", err)
+	}
+	return src, nil
+}
+
+// Returns true if the id is in the list.
+func containsId(ids []int64, id int64) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Converts a name into an exported Go identifier.
+func goName(s string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	name := b.String()
+	if name == "" || !unicode.IsUpper([]rune(name)[0]) {
+		name = "X" + name
+	}
+	return name
+}
+
+// Converts an exported Go identifier into an unexported one.
+func lowerFirst(s string) string {
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+// Reserves a unique top level name.
+func (g *generator) unique(name string) string {
+	ret := name
+	for i := 2; g.names[ret]; i++ {
+		ret = name + strconv.Itoa(i)
+	}
+	g.names[ret] = true
+	return ret
+}
+
+// Writes a comment with the given text.
+func writeComment(b *bytes.Buffer, indent string, text string) {
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		fmt.Fprintf(b, "%s// %s\n", indent, strings.TrimRight(line, " \t\r"))
+	}
+}
+
+// Returns the text as a Go string literal.
+func stringLiteral(s string) string {
+	if !strings.Contains(s, "`") {
+		return "`" + s + "`"
+	}
+	return strconv.Quote(s)
+}
+
+// Generates the types of an application.
+func (g *generator) generateApp(app *models.IInterlockAppTraits) error {
+	appName := g.unique(goName(app.Name) + "AppId")
+	fmt.Fprintf(&g.buf, "\n// Id of the application %s.\nconst %s int64 = %d\n", app.Name, appName, app.Id)
+	for i := range app.DataModels {
+		if err := g.generateDataModel(app.Name, appName, &app.DataModels[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Generates the type of a payload.
+func (g *generator) generateDataModel(app string, appName string, dm *models.DataModel) error {
+	name := g.unique(goName(dm.PayloadName))
+	dmName := g.unique(lowerFirst(name) + "DataModel")
+	tagName := g.unique(name + "PayloadTagId")
+	dmJSON, err := json.MarshalIndent(dm, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	doc := dm.Description
+	if doc == "" {
+		doc = fmt.Sprintf("Payload %s.", dm.PayloadName)
+	}
+	doc += fmt.Sprintf("\n\nVersion %d of the data model.", dm.Version)
+	if err := g.generateStruct(name, doc, dm.DataFields); err != nil {
+		return err
+	}
+	fmt.Fprintf(&g.buf, "\n// Tag id of the payload %s.\nconst %s int64 = %d\n", name, tagName, dm.PayloadTagId)
+	fmt.Fprintf(&g.buf, `
+// Data model of the payload %[1]s.
+var %[2]s = func() (dm models.DataModel) {
+	if err := json.Unmarshal([]byte(%[3]s), &dm); err != nil {
+		panic(err)
+	}
+	return
+}()
+
+// Returns the data model of the payload.
+func (*%[1]s) DataModel() models.DataModel {
+	return %[2]s
+}
+
+// Encodes the payload. See models.MarshalPayload().
+func (p *%[1]s) MarshalPayload() ([]byte, error) {
+	return models.MarshalPayload(p, &%[2]s)
+}
+
+// Decodes the payload. See models.UnmarshalPayload().
+func (p *%[1]s) UnmarshalPayload(payload []byte) error {
+	return models.UnmarshalPayload(payload, &%[2]s, p)
+}
+
+// Creates a new record of the application %[5]s with this payload.
+func (p *%[1]s) NewRecord() (models.NewRecordModel, error) {
+	m := models.NewRecordModel{ApplicationId: %[4]s}
+	if err := m.SetPayload(p, &%[2]s); err != nil {
+		return models.NewRecordModel{}, err
+	}
+	return m, nil
+}
+`, name, dmName, stringLiteral(string(dmJSON)), appName, app)
+	for len(g.pending) > 0 {
+		s := g.pending[0]
+		g.pending = g.pending[1:]
+		if err := g.generateStruct(s.name, s.doc, s.fields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Generates a struct with the given fields.
+func (g *generator) generateStruct(name string, doc string, fields []models.DataField) error {
+	var b bytes.Buffer
+	fieldNames := make(map[string]bool)
+	for i := range fields {
+		field := &fields[i]
+		fieldName := goName(field.Name)
+		for j := 2; fieldNames[fieldName]; j++ {
+			fieldName = goName(field.Name) + strconv.Itoa(j)
+		}
+		fieldNames[fieldName] = true
+		goType, err := g.fieldType(name+fieldName, field)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", name, field.Name, err)
+		}
+		var comment []string
+		if field.Description != "" {
+			comment = append(comment, field.Description)
+		}
+		if field.Version > 1 {
+			comment = append(comment, fmt.Sprintf("Since version %d.", field.Version))
+		}
+		if field.IsDeprecated {
+			comment = append(comment, "Deprecated: the field is deprecated by the data model.")
+		}
+		if len(comment) > 0 {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+			writeComment(&b, "\t", strings.Join(comment, "\n\n"))
+		}
+		b.WriteString("\t")
+		fmt.Fprintf(&b, "%s %s `json:%q iltag:\"name=%s,tagId=%d\"`\n",
+			fieldName, goType, field.Name, field.Name, field.TagId)
+	}
+	g.buf.WriteString("\n")
+	writeComment(&g.buf, "", doc)
+	fmt.Fprintf(&g.buf, "type %s struct {\n%s}\n", name, b.String())
+	return nil
+}
+
+// Returns the Go type of a field.
+func (g *generator) fieldType(name string, field *models.DataField) (string, error) {
+	id := tags.TagID(field.TagId)
+	if field.IsOpaque {
+		return "[]byte", nil
+	}
+	if !id.Reserved() {
+		if len(field.SubDataFields) == 0 {
+			return "[]byte", nil
+		}
+		return g.subStruct(name, field), nil
+	}
+	if field.Enumeration != nil && len(field.Enumeration.Items) > 0 {
+		if base, ok := integerTypes[id]; ok {
+			return g.enumeration(name, base, field), nil
+		}
+	}
+	if field.Cast != nil {
+		if _, ok := integerTypes[id]; ok {
+			switch *field.Cast {
+			case models.DATE_TIME_CastType:
+				g.needTime = true
+				return "time.Time", nil
+			case models.TIME_SPAN_CastType:
+				g.needTime = true
+				return "time.Duration", nil
+			case models.INTEGER_CastType:
+				return "int64", nil
+			}
+		}
+	}
+	if t, ok := integerTypes[id]; ok {
+		return t, nil
+	}
+	switch id {
+	case tags.IL_NULL_TAG_ID:
+		return "any", nil
+	case tags.IL_DICTIONARY_TAG_ID:
+		return "map[string]any", nil
+	case tags.IL_BOOL_TAG_ID:
+		return "bool", nil
+	case tags.IL_BIN32_TAG_ID:
+		return "float32", nil
+	case tags.IL_BIN64_TAG_ID:
+		return "float64", nil
+	case tags.IL_BIN128_TAG_ID, tags.IL_BYTES_TAG_ID:
+		return "[]byte", nil
+	case tags.IL_STRING_TAG_ID, tags.IL_BDEC_TAG_ID, tags.IL_VERSION_TAG_ID:
+		return "string", nil
+	case tags.IL_BINT_TAG_ID:
+		g.needBig = true
+		return "*big.Int", nil
+	case tags.IL_ILINTARRAY_TAG_ID, tags.IL_OID_TAG_ID:
+		return "[]uint64", nil
+	case tags.IL_RANGE_TAG_ID:
+		return "struct {\nStart uint64\nCount uint16\n}", nil
+	case tags.IL_STRING_DICTIONARY_TAG_ID:
+		return "map[string]string", nil
+	case tags.IL_ILTAGARRAY_TAG_ID, tags.IL_ILTAGSEQ_TAG_ID:
+		if field.ElementTagId == 0 {
+			return "[]any", nil
+		}
+		element := models.DataField{
+			Name:          field.Name,
+			TagId:         field.ElementTagId,
+			SubDataFields: field.SubDataFields,
+		}
+		t, err := g.fieldType(name, &element)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + t, nil
+	}
+	return "", fmt.Errorf("unsupported tag %d", id)
+}
+
+// Go types of the integer tags.
+var integerTypes = map[tags.TagID]string{
+	tags.IL_INT8_TAG_ID:         "int8",
+	tags.IL_UINT8_TAG_ID:        "uint8",
+	tags.IL_INT16_TAG_ID:        "int16",
+	tags.IL_UINT16_TAG_ID:       "uint16",
+	tags.IL_INT32_TAG_ID:        "int32",
+	tags.IL_UINT32_TAG_ID:       "uint32",
+	tags.IL_INT64_TAG_ID:        "int64",
+	tags.IL_UINT64_TAG_ID:       "uint64",
+	tags.IL_ILINT_TAG_ID:        "uint64",
+	tags.IL_SIGNED_ILINT_TAG_ID: "int64",
+}
+
+// Schedules the generation of the struct of a field with sub fields.
+func (g *generator) subStruct(name string, field *models.DataField) string {
+	name = g.unique(name)
+	doc := field.Description
+	if doc == "" {
+		doc = fmt.Sprintf("Value of the field %s.", field.Name)
+	}
+	g.pending = append(g.pending, pendingStruct{name: name, doc: doc, fields: field.SubDataFields})
+	return "*" + name
+}
+
+// Generates the type of an enumeration and returns its name.
+func (g *generator) enumeration(name string, base string, field *models.DataField) string {
+	name = g.unique(name)
+	items := g.unique(lowerFirst(name) + "Enumeration")
+	values := make([]uint64, 0, len(field.Enumeration.Items))
+	for k := range field.Enumeration.Items {
+		values = append(values, k)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	kind := "Values"
+	if field.EnumerationAsFlags {
+		kind = "Flags"
+	}
+	fmt.Fprintf(&g.buf, "\n// %s of the field %s.\ntype %s %s\n\nconst (\n", kind, field.Name, name, base)
+	for _, v := range values {
+		item := field.Enumeration.Items[v]
+		if item.Description != "" {
+			writeComment(&g.buf, "\t", item.Description)
+		}
+		fmt.Fprintf(&g.buf, "\t%s %s = %d\n", g.unique(name+goName(item.Name)), name, v)
+	}
+	fmt.Fprintf(&g.buf, ")\n\n// Values of %s.\nvar %s = models.EnumerationItems{Items: map[uint64]models.EnumerationItem{\n", name, items)
+	for _, v := range values {
+		item := field.Enumeration.Items[v]
+		if item.Description == "" {
+			fmt.Fprintf(&g.buf, "\t%d: {Name: %q},\n", v, item.Name)
+		} else {
+			fmt.Fprintf(&g.buf, "\t%d: {Name: %q, Description: %q},\n", v, item.Name, item.Description)
+		}
+	}
+	fmt.Fprintf(&g.buf, `}}
+
+// Returns the name of the value.
+func (v %[1]s) String() string {
+	return %[2]s.Format(uint64(v), %[3]t)
+}
+
+// Implements encoding.TextMarshaler.
+func (v %[1]s) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// Implements encoding.TextUnmarshaler.
+func (v *%[1]s) UnmarshalText(b []byte) error {
+	n, err := %[2]s.Parse(string(b), %[3]t)
+	if err != nil {
+		return err
+	}
+	*v = %[1]s(n)
+	return nil
+}
+`, name, items, field.EnumerationAsFlags)
+	return name
+}
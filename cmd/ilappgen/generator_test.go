@@ -0,0 +1,113 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	// internal/example/example.go is generated from testdata/apps.json by go generate.
+	apps, err := loadAppsFile("testdata/apps.json")
+	require.NoError(t, err)
+	src, err := generate(apps, "example", nil)
+	require.NoError(t, err)
+	expected, err := os.ReadFile("internal/example/example.go")
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(src), "run go generate ./... to update the example")
+
+	src, err = generate(apps, "example", []int64{8})
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(src))
+
+	_, err = generate(apps, "example", []int64{9})
+	assert.EqualError(t, err, "no application to generate")
+
+	apps.ValidApps[0].DataModels[0].DataFields[1].TagId = 15
+	_, err = generate(apps, "example", nil)
+	assert.EqualError(t, err, "Product.name: unsupported tag 15")
+}
+
+func TestGenerateNames(t *testing.T) {
+	apps := &models.AppsModel{ValidApps: []models.IInterlockAppTraits{{
+		Id:   1,
+		Name: "my app",
+		DataModels: []models.DataModel{
+			{PayloadName: "item", PayloadTagId: 1000, DataFields: []models.DataField{
+				{Name: "value", TagId: 10}, {Name: "Value", TagId: 17}, {Name: "2nd", TagId: 1}}},
+			{PayloadName: "Item", PayloadTagId: 1001},
+		},
+	}}}
+	src, err := generate(apps, "apps", nil)
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "const MyAppAppId int64 = 1")
+	assert.Contains(t, string(src), "type Item struct")
+	assert.Contains(t, string(src), "type Item2 struct")
+	assert.Contains(t, string(src), "Value  uint64")
+	assert.Contains(t, string(src), "Value2 string")
+	assert.Contains(t, string(src), "X2nd   bool")
+	assert.NotContains(t, string(src), `"time"`)
+}
+
+func TestGoName(t *testing.T) {
+	assert.Equal(t, "PayloadName", goName("payload_name"))
+	assert.Equal(t, "CreatedAt", goName("createdAt"))
+	assert.Equal(t, "ABC", goName("a-b c"))
+	assert.Equal(t, "X1st", goName("1st"))
+	assert.Equal(t, "X", goName("!"))
+}
+
+func TestRun(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "apps.go")
+	require.NoError(t, run([]string{"-input", "testdata/apps.json", "-package", "example",
+		"-app", "8", "-output", output}, nil))
+	expected, err := os.ReadFile("internal/example/example.go")
+	require.NoError(t, err)
+	src, err := os.ReadFile(output)
+	require.NoError(t, err)
+	assert.Equal(t, expected, src)
+
+	var stdout bytes.Buffer
+	require.NoError(t, run([]string{"-input", "testdata/apps.json", "-package", "example"}, &stdout))
+	assert.Equal(t, expected, stdout.Bytes())
+
+	assert.EqualError(t, run(nil, &stdout), "either -config or -input must be set")
+	assert.EqualError(t, run([]string{"-input", "x", "-config", "y"}, &stdout), "either -config or -input must be set")
+	assert.Error(t, run([]string{"-input", "testdata/none.json"}, &stdout))
+	assert.Error(t, run([]string{"-input", "testdata/apps.json", "-app", "x"}, &stdout))
+}
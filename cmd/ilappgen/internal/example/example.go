@@ -0,0 +1,288 @@
+// Code generated by ilappgen. DO NOT EDIT.
+
+// Data models of the network Example.
+
+package example
+
+import (
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+)
+
+// Id of the application Inventory.
+const InventoryAppId int64 = 8
+
+// Values of the field kind.
+type ProductKind uint64
+
+const (
+	ProductKindNone ProductKind = 0
+	// A simple product.
+	ProductKindSimple  ProductKind = 1
+	ProductKindComplex ProductKind = 2
+)
+
+// Values of ProductKind.
+var productKindEnumeration = models.EnumerationItems{Items: map[uint64]models.EnumerationItem{
+	0: {Name: "None"},
+	1: {Name: "Simple", Description: "A simple product."},
+	2: {Name: "Complex"},
+}}
+
+// Returns the name of the value.
+func (v ProductKind) String() string {
+	return productKindEnumeration.Format(uint64(v), false)
+}
+
+// Implements encoding.TextMarshaler.
+func (v ProductKind) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// Implements encoding.TextUnmarshaler.
+func (v *ProductKind) UnmarshalText(b []byte) error {
+	n, err := productKindEnumeration.Parse(string(b), false)
+	if err != nil {
+		return err
+	}
+	*v = ProductKind(n)
+	return nil
+}
+
+// Flags of the field flags.
+type ProductFlags uint8
+
+const (
+	ProductFlagsNone       ProductFlags = 0
+	ProductFlagsFragile    ProductFlags = 1
+	ProductFlagsPerishable ProductFlags = 2
+)
+
+// Values of ProductFlags.
+var productFlagsEnumeration = models.EnumerationItems{Items: map[uint64]models.EnumerationItem{
+	0: {Name: "None"},
+	1: {Name: "Fragile"},
+	2: {Name: "Perishable"},
+}}
+
+// Returns the name of the value.
+func (v ProductFlags) String() string {
+	return productFlagsEnumeration.Format(uint64(v), true)
+}
+
+// Implements encoding.TextMarshaler.
+func (v ProductFlags) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// Implements encoding.TextUnmarshaler.
+func (v *ProductFlags) UnmarshalText(b []byte) error {
+	n, err := productFlagsEnumeration.Parse(string(b), true)
+	if err != nil {
+		return err
+	}
+	*v = ProductFlags(n)
+	return nil
+}
+
+// A product of the inventory.
+//
+// Version 2 of the data model.
+type Product struct {
+	Version uint64 `json:"Version" iltag:"name=Version,tagId=10"`
+
+	// Name of the product.
+	Name      string        `json:"name" iltag:"name=name,tagId=17"`
+	Kind      ProductKind   `json:"kind" iltag:"name=kind,tagId=10"`
+	Flags     ProductFlags  `json:"flags" iltag:"name=flags,tagId=3"`
+	CreatedAt time.Time     `json:"createdAt" iltag:"name=createdAt,tagId=8"`
+	ShelfLife time.Duration `json:"shelfLife" iltag:"name=shelfLife,tagId=14"`
+	Price     string        `json:"price" iltag:"name=price,tagId=19"`
+	Stock     *big.Int      `json:"stock" iltag:"name=stock,tagId=18"`
+
+	// Deprecated: the field is deprecated by the data model.
+	LegacyCode string           `json:"legacyCode" iltag:"name=legacyCode,tagId=17"`
+	Signature  []byte           `json:"signature" iltag:"name=signature,tagId=1001"`
+	Supplier   *ProductSupplier `json:"supplier" iltag:"name=supplier,tagId=1002"`
+
+	// Parts of a complex product.
+	//
+	// Since version 2.
+	Parts []*ProductParts `json:"parts" iltag:"name=parts,tagId=21"`
+
+	// Since version 2.
+	Tags map[string]string `json:"tags" iltag:"name=tags,tagId=31"`
+}
+
+// Tag id of the payload Product.
+const ProductPayloadTagId int64 = 1000
+
+// Data model of the payload Product.
+var productDataModel = func() (dm models.DataModel) {
+	if err := json.Unmarshal([]byte(`{
+	"dataFields": [
+		{
+			"name": "Version",
+			"tagId": 10,
+			"version": 1
+		},
+		{
+			"description": "Name of the product.",
+			"name": "name",
+			"tagId": 17,
+			"version": 1
+		},
+		{
+			"enumeration": {
+				"0": {
+					"name": "None"
+				},
+				"1": {
+					"name": "Simple",
+					"description": "A simple product."
+				},
+				"2": {
+					"name": "Complex"
+				}
+			},
+			"name": "kind",
+			"tagId": 10,
+			"version": 1
+		},
+		{
+			"enumeration": {
+				"0": {
+					"name": "None"
+				},
+				"1": {
+					"name": "Fragile"
+				},
+				"2": {
+					"name": "Perishable"
+				}
+			},
+			"enumerationAsFlags": true,
+			"name": "flags",
+			"tagId": 3,
+			"version": 1
+		},
+		{
+			"cast": "DateTime",
+			"name": "createdAt",
+			"tagId": 8,
+			"version": 1
+		},
+		{
+			"cast": "TimeSpan",
+			"name": "shelfLife",
+			"tagId": 14,
+			"version": 1
+		},
+		{
+			"name": "price",
+			"tagId": 19,
+			"version": 1
+		},
+		{
+			"name": "stock",
+			"tagId": 18,
+			"version": 1
+		},
+		{
+			"isDeprecated": true,
+			"name": "legacyCode",
+			"tagId": 17,
+			"version": 1
+		},
+		{
+			"isOpaque": true,
+			"name": "signature",
+			"tagId": 1001,
+			"version": 1
+		},
+		{
+			"name": "supplier",
+			"subDataFields": [
+				{
+					"name": "id",
+					"tagId": 10
+				},
+				{
+					"name": "name",
+					"tagId": 17
+				}
+			],
+			"tagId": 1002,
+			"version": 1
+		},
+		{
+			"description": "Parts of a complex product.",
+			"elementTagId": 1003,
+			"name": "parts",
+			"subDataFields": [
+				{
+					"name": "name",
+					"tagId": 17
+				},
+				{
+					"name": "quantity",
+					"tagId": 7
+				}
+			],
+			"tagId": 21,
+			"version": 2
+		},
+		{
+			"name": "tags",
+			"tagId": 31,
+			"version": 2
+		}
+	],
+	"description": "A product of the inventory.",
+	"payloadName": "Product",
+	"payloadTagId": 1000,
+	"version": 2
+}`), &dm); err != nil {
+		panic(err)
+	}
+	return
+}()
+
+// Returns the data model of the payload.
+func (*Product) DataModel() models.DataModel {
+	return productDataModel
+}
+
+// Encodes the payload. See models.MarshalPayload().
+func (p *Product) MarshalPayload() ([]byte, error) {
+	return models.MarshalPayload(p, &productDataModel)
+}
+
+// Decodes the payload. See models.UnmarshalPayload().
+func (p *Product) UnmarshalPayload(payload []byte) error {
+	return models.UnmarshalPayload(payload, &productDataModel, p)
+}
+
+// Creates a new record of the application Inventory with this payload.
+func (p *Product) NewRecord() (models.NewRecordModel, error) {
+	m := models.NewRecordModel{ApplicationId: InventoryAppId}
+	if err := m.SetPayload(p, &productDataModel); err != nil {
+		return models.NewRecordModel{}, err
+	}
+	return m, nil
+}
+
+// Value of the field supplier.
+type ProductSupplier struct {
+	Id   uint64 `json:"id" iltag:"name=id,tagId=10"`
+	Name string `json:"name" iltag:"name=name,tagId=17"`
+}
+
+// Value of the field parts.
+type ProductParts struct {
+	Name     string `json:"name" iltag:"name=name,tagId=17"`
+	Quantity uint32 `json:"quantity" iltag:"name=quantity,tagId=7"`
+}
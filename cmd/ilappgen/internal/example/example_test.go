@@ -0,0 +1,107 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package example
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct(t *testing.T) {
+	p := Product{
+		Version:   2,
+		Name:      "Widget",
+		Kind:      ProductKindComplex,
+		Flags:     ProductFlagsFragile | ProductFlagsPerishable,
+		CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 600, time.UTC),
+		ShelfLife: 48 * time.Hour,
+		Price:     "12.50",
+		Stock:     big.NewInt(-3),
+		Signature: []byte{1, 2, 3},
+		Supplier:  &ProductSupplier{Id: 1, Name: "ACME"},
+		Parts:     []*ProductParts{{Name: "bolt", Quantity: 4}, {Name: "nut", Quantity: 4}},
+		Tags:      map[string]string{"color": "red"},
+	}
+	payload, err := p.MarshalPayload()
+	require.NoError(t, err)
+
+	var decoded Product
+	require.NoError(t, decoded.UnmarshalPayload(payload))
+	assert.Zero(t, p.Stock.Cmp(decoded.Stock))
+	decoded.Stock = p.Stock
+	assert.True(t, p.CreatedAt.Equal(decoded.CreatedAt))
+	decoded.CreatedAt = p.CreatedAt
+	assert.Equal(t, p, decoded)
+
+	dm := p.DataModel()
+	fields, err := models.DecodePayloadWith(payload, &dm)
+	require.NoError(t, err)
+	assert.Equal(t, "Complex", fields["kind"])
+	assert.Equal(t, "Fragile|Perishable", fields["flags"])
+	assert.Equal(t, "Fragile|Perishable", p.Flags.String())
+
+	record, err := p.NewRecord()
+	require.NoError(t, err)
+	assert.Equal(t, InventoryAppId, record.ApplicationId)
+	assert.Equal(t, models.EncodeBytes(payload), record.PayloadBytes)
+}
+
+func TestProductVersion(t *testing.T) {
+	p := Product{Version: 1, Name: "Old"}
+	payload, err := p.MarshalPayload()
+	require.NoError(t, err)
+	var decoded Product
+	require.NoError(t, decoded.UnmarshalPayload(payload))
+	assert.Equal(t, "Old", decoded.Name)
+	assert.Equal(t, uint64(1), decoded.Version)
+
+	p.Parts = []*ProductParts{{Name: "bolt"}}
+	_, err = p.MarshalPayload()
+	assert.ErrorIs(t, err, models.ErrPayloadMismatch)
+
+	p = Product{Version: 3}
+	_, err = p.MarshalPayload()
+	assert.ErrorIs(t, err, models.ErrPayloadMismatch)
+}
+
+func TestProductKindText(t *testing.T) {
+	var k ProductKind
+	require.NoError(t, k.UnmarshalText([]byte("Simple")))
+	assert.Equal(t, ProductKindSimple, k)
+	require.NoError(t, k.UnmarshalText([]byte("7")))
+	assert.Equal(t, "7", k.String())
+	assert.Error(t, k.UnmarshalText([]byte("Unknown")))
+}
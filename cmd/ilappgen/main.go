@@ -0,0 +1,163 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Command ilappgen generates Go types for the payloads of the applications of an
+InterlockLedger network.
+
+The data models are read from a node, using a client configuration file as
+described in client.LoadConfiguration(), or from a JSON file with the
+models.AppsModel returned by NodeApi.AppsList():
+
+	ilappgen -config config.json -package apps -output apps.go
+	ilappgen -input apps.json -app 8 -package apps -output apps.go
+
+For each data model it generates a struct with the fields of the payload, a
+type with constants for each enumeration and the methods MarshalPayload(),
+UnmarshalPayload() and NewRecord(), which use models.MarshalPayload() and
+models.UnmarshalPayload() to encode and decode the ILTag payloads. Fields with
+sub fields are generated as structs of their own.
+*/
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/interlockledger/go-interlockledger-rest-client/client"
+	"github.com/interlockledger/go-interlockledger-rest-client/client/models"
+)
+
+// List of application ids set by a repeatable flag.
+type idList []int64
+
+// Implements flag.Value.
+func (l *idList) String() string {
+	s := make([]string, len(*l))
+	for i, id := range *l {
+		s[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(s, ",")
+}
+
+// Implements flag.Value.
+func (l *idList) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid application id %q", v)
+		}
+		*l = append(*l, id)
+	}
+	return nil
+}
+
+// Loads the applications from a JSON file or from the standard input if the
+// file is "-".
+func loadAppsFile(file string) (*models.AppsModel, error) {
+	var r io.Reader = os.Stdin
+	if file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+	var apps models.AppsModel
+	if err := json.NewDecoder(r).Decode(&apps); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", file, err)
+	}
+	return &apps, nil
+}
+
+// Loads the applications from the node.
+func loadAppsFromNode(configFile string) (*models.AppsModel, error) {
+	configuration, err := client.LoadConfiguration(configFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load the configuration: %w", err)
+	}
+	apps, _, err := client.NewAPIClient(configuration).NodeApi.AppsList(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the applications: %w", err)
+	}
+	return &apps, nil
+}
+
+func run(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("ilappgen", flag.ContinueOnError)
+	configFile := flags.String("config", "", "client configuration used to read the applications from the node")
+	inputFile := flags.String("input", "", "JSON file with the applications (\"-\" for the standard input)")
+	pkg := flags.String("package", "apps", "name of the generated package")
+	outputFile := flags.String("output", "", "output file (the standard output by default)")
+	var appIds idList
+	flags.Var(&appIds, "app", "id of the application to generate, can be repeated (all by default)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if (*configFile == "") == (*inputFile == "") {
+		return fmt.Errorf("either -config or -input must be set")
+	}
+
+	var apps *models.AppsModel
+	var err error
+	if *inputFile != "" {
+		apps, err = loadAppsFile(*inputFile)
+	} else {
+		apps, err = loadAppsFromNode(*configFile)
+	}
+	if err != nil {
+		return err
+	}
+	src, err := generate(apps, *pkg, appIds)
+	if err != nil {
+		return err
+	}
+	if *outputFile == "" {
+		_, err = stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(*outputFile, src, 0644)
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		if err != flag.ErrHelp {
+			fmt.Fprintf(os.Stderr, "ilappgen: %v\n", err)
+		}
+		os.Exit(1)
+	}
+}
@@ -159,3 +159,41 @@ func ParseCertificateWithKeyFromPKCS12(bytes []byte, password string) (tls.Certi
 	}
 	return tls.X509KeyPair(pemData, pemData)
 }
+
+/*
+Creates a certificate whose private key operations are delegated to the given
+signer. It allows the use of keys that are not available in memory, such as the
+ones stored inside key agents or hardware keystores. The signer must hold a RSA,
+ECDSA or Ed25519 key that matches the public key of the first certificate of
+the chain.
+*/
+func NewCertificateWithSigner(chain []*x509.Certificate, signer crypto.Signer) (tls.Certificate, error) {
+	if len(chain) == 0 {
+		return tls.Certificate{}, fmt.Errorf("empty certificate chain")
+	}
+	if signer == nil {
+		return tls.Certificate{}, ErrInvalidPrivateKey
+	}
+	pub, ok := signer.Public().(interface {
+		Equal(crypto.PublicKey) bool
+	})
+	if !ok {
+		return tls.Certificate{}, ErrInvalidPrivateKey
+	}
+	switch pub.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+	default:
+		return tls.Certificate{}, fmt.Errorf("unsupported key type %T: %w", pub, ErrInvalidPrivateKey)
+	}
+	if !pub.Equal(chain[0].PublicKey) {
+		return tls.Certificate{}, fmt.Errorf("the signer does not match the certificate: %w", ErrInvalidPrivateKey)
+	}
+	cert := tls.Certificate{
+		PrivateKey: signer,
+		Leaf:       chain[0],
+	}
+	for _, c := range chain {
+		cert.Certificate = append(cert.Certificate, c.Raw)
+	}
+	return cert, nil
+}
@@ -31,10 +31,21 @@
 package crypto
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLoadCertificateWithKey(t *testing.T) {
@@ -99,3 +110,70 @@ func TestLoadCertificateWithKeyFromPKCS12(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, cert.PrivateKey)
 }
+
+// Signer that hides the actual private key.
+type opaqueSigner struct {
+	signer crypto.Signer
+}
+
+func (s *opaqueSigner) Public() crypto.PublicKey {
+	return s.signer.Public()
+}
+
+func (s *opaqueSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return s.signer.Sign(rand, digest, opts)
+}
+
+func createSelfSigned(t *testing.T, signer crypto.Signer) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, signer.Public(), signer)
+	require.Nil(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.Nil(t, err)
+	return cert
+}
+
+func TestNewCertificateWithSigner(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+
+	for _, key := range []crypto.Signer{rsaKey, ecKey, edKey} {
+		signer := &opaqueSigner{key}
+		c := createSelfSigned(t, key)
+		cert, err := NewCertificateWithSigner([]*x509.Certificate{c, c}, signer)
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{c.Raw, c.Raw}, cert.Certificate)
+		assert.Same(t, c, cert.Leaf)
+		assert.Same(t, signer, cert.PrivateKey)
+	}
+
+	c := createSelfSigned(t, rsaKey)
+	_, err = NewCertificateWithSigner(nil, rsaKey)
+	assert.Error(t, err)
+	_, err = NewCertificateWithSigner([]*x509.Certificate{c}, nil)
+	assert.ErrorIs(t, err, ErrInvalidPrivateKey)
+	_, err = NewCertificateWithSigner([]*x509.Certificate{c}, ecKey)
+	assert.ErrorIs(t, err, ErrInvalidPrivateKey)
+	_, err = NewCertificateWithSigner([]*x509.Certificate{c}, &opaqueSigner{&dummySigner{}})
+	assert.ErrorIs(t, err, ErrInvalidPrivateKey)
+}
+
+// Signer with an unsupported public key.
+type dummySigner struct{}
+
+func (s *dummySigner) Public() crypto.PublicKey {
+	return "key"
+}
+
+func (s *dummySigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return nil, nil
+}
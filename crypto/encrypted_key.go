@@ -0,0 +1,323 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2022, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package crypto
+
+import (
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"os"
+
+	"github.com/interlockledger/go-iltags/utils"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+var (
+	// The password used to decrypt the private key is incorrect.
+	ErrIncorrectPassword = fmt.Errorf("incorrect private key password")
+	// The private key is encrypted with an algorithm that is not supported.
+	ErrUnsupportedKeyEncryption = fmt.Errorf("unsupported private key encryption algorithm")
+)
+
+var (
+	oidPBES2          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidHMACWithSHA1   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	oidHMACWithSHA224 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 8}
+	oidHMACWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidHMACWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 10}
+	oidHMACWithSHA512 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 11}
+	oidAES128CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES192CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	oidAES256CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+	oidDESEDE3CBC     = asn1.ObjectIdentifier{1, 2, 840, 113549, 3, 7}
+)
+
+// EncryptedPrivateKeyInfo as defined by RFC 5958.
+type encryptedPrivateKeyInfo struct {
+	Algorithm     pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+// PBES2-params as defined by RFC 8018.
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+/*
+Maximum number of PBKDF2 iterations accepted. It is much larger than the values
+used in practice but prevents crafted keys from pinning the CPU.
+*/
+const maxPBKDF2Iterations = 10000000
+
+// PBKDF2-params as defined by RFC 8018.
+type pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	KeyLength      int                      `asn1:"optional"`
+	PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+/*
+Loads the private key from a PEM file that may be encrypted with the given
+password. See ParsePrivateKeyWithPassword() for further details.
+*/
+func LoadPrivateKeyWithPassword(keyFile string, password string) (crypto.PrivateKey, error) {
+	bytes, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePrivateKeyWithPassword(bytes, password)
+}
+
+/*
+Parses a private key from a PEM file that may be encrypted with the given
+password. It can load RSA, ECDSA and EdDSA keys.
+
+It supports PKCS #8 keys encrypted with PBES2 (ENCRYPTED PRIVATE KEY) using
+PBKDF2 with AES-CBC or 3DES-CBC, as well as the legacy OpenSSL encrypted PEM
+blocks (Proc-Type: 4,ENCRYPTED). Unencrypted keys are also accepted, in which
+case the password is ignored.
+
+It returns ErrIncorrectPassword if the password does not decrypt the key and
+ErrUnsupportedKeyEncryption if the key is encrypted with an algorithm that is
+not supported by this library.
+*/
+func ParsePrivateKeyWithPassword(bytes []byte, password string) (crypto.PrivateKey, error) {
+	pemBlock, _ := pem.Decode(bytes)
+	if pemBlock == nil {
+		return nil, ErrInvalidPrivateKey
+	}
+	var der []byte
+	var err error
+	switch {
+	case pemBlock.Type == "ENCRYPTED PRIVATE KEY":
+		der, err = decryptPKCS8(pemBlock.Bytes, []byte(password))
+	//lint:ignore SA1019 Legacy encrypted PEM blocks are supported on purpose.
+	case x509.IsEncryptedPEMBlock(pemBlock):
+		der, err = decryptLegacyPEMBlock(pemBlock, []byte(password))
+	case pemBlock.Type == "PRIVATE KEY" || pemBlock.Type == "RSA PRIVATE KEY" ||
+		pemBlock.Type == "EC PRIVATE KEY":
+		return parsePrivateKeyDER(pemBlock.Bytes)
+	default:
+		return nil, ErrInvalidPrivateKey
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer utils.ShredBytes(der)
+	key, err := parsePrivateKeyDER(der)
+	if err != nil {
+		// The padding may be valid by chance even if the password is wrong.
+		return nil, ErrIncorrectPassword
+	}
+	return key, nil
+}
+
+/*
+Loads a certificate with its private key. Both the certificate and the key must
+be in PEM format. The private key may be encrypted with the given password. See
+ParsePrivateKeyWithPassword() for further details.
+*/
+func LoadCertificateWithEncryptedKey(certificateFile string, keyFile string, password string) (tls.Certificate, error) {
+	certs, err := LoadCertificate(certificateFile)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	key, err := LoadPrivateKeyWithPassword(keyFile, password)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return tls.Certificate{}, ErrInvalidPrivateKey
+	}
+	cert, err := NewCertificateWithSigner(certs, signer)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	cert.PrivateKey = key
+	return cert, nil
+}
+
+// Parses a DER encoded private key in PKCS #1, PKCS #8 or SEC 1 format.
+func parsePrivateKeyDER(der []byte) (crypto.PrivateKey, error) {
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(der); err == nil {
+		switch key.(type) {
+		case *rsa.PrivateKey, *ecdsa.PrivateKey, ed25519.PrivateKey:
+			return key, nil
+		default:
+			return nil, ErrInvalidPrivateKey
+		}
+	}
+	if key, err := x509.ParseECPrivateKey(der); err == nil {
+		return key, nil
+	}
+	return nil, ErrInvalidPrivateKey
+}
+
+// Decrypts a legacy OpenSSL encrypted PEM block.
+func decryptLegacyPEMBlock(pemBlock *pem.Block, password []byte) ([]byte, error) {
+	//lint:ignore SA1019 Legacy encrypted PEM blocks are supported on purpose.
+	der, err := x509.DecryptPEMBlock(pemBlock, password)
+	if err == nil {
+		return der, nil
+	}
+	if errors.Is(err, x509.IncorrectPasswordError) {
+		return nil, ErrIncorrectPassword
+	}
+	if pemBlock.Headers["DEK-Info"] != "" {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKeyEncryption, err.Error())
+	}
+	return nil, fmt.Errorf("%w: %s", ErrInvalidPrivateKey, err.Error())
+}
+
+// Decrypts a PKCS #8 EncryptedPrivateKeyInfo protected by PBES2.
+func decryptPKCS8(der []byte, password []byte) ([]byte, error) {
+	var info encryptedPrivateKeyInfo
+	if rest, err := asn1.Unmarshal(der, &info); err != nil || len(rest) != 0 {
+		return nil, ErrInvalidPrivateKey
+	}
+	if !info.Algorithm.Algorithm.Equal(oidPBES2) {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKeyEncryption, info.Algorithm.Algorithm)
+	}
+	var params pbes2Params
+	if _, err := asn1.Unmarshal(info.Algorithm.Parameters.FullBytes, &params); err != nil {
+		return nil, ErrInvalidPrivateKey
+	}
+	if !params.KeyDerivationFunc.Algorithm.Equal(oidPBKDF2) {
+		return nil, fmt.Errorf("%w: key derivation %s", ErrUnsupportedKeyEncryption,
+			params.KeyDerivationFunc.Algorithm)
+	}
+	var kdfParams pbkdf2Params
+	if _, err := asn1.Unmarshal(params.KeyDerivationFunc.Parameters.FullBytes, &kdfParams); err != nil {
+		return nil, ErrInvalidPrivateKey
+	}
+	if kdfParams.IterationCount < 1 || kdfParams.IterationCount > maxPBKDF2Iterations {
+		return nil, fmt.Errorf("%w: invalid PBKDF2 iteration count %d", ErrInvalidPrivateKey,
+			kdfParams.IterationCount)
+	}
+	prf, err := pbkdf2PRF(kdfParams.PRF.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	newCipher, keySize, err := pbes2Cipher(params.EncryptionScheme.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if kdfParams.KeyLength != 0 && kdfParams.KeyLength != keySize {
+		return nil, ErrInvalidPrivateKey
+	}
+	var iv []byte
+	if _, err := asn1.Unmarshal(params.EncryptionScheme.Parameters.FullBytes, &iv); err != nil {
+		return nil, ErrInvalidPrivateKey
+	}
+	key := pbkdf2.Key(password, kdfParams.Salt, kdfParams.IterationCount, keySize, prf)
+	defer utils.ShredBytes(key)
+	block, err := newCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	blockSize := block.BlockSize()
+	if len(iv) != blockSize {
+		return nil, ErrInvalidBlockCipherIv
+	}
+	if len(info.EncryptedData) == 0 || len(info.EncryptedData)%blockSize != 0 {
+		return nil, ErrInvalidEncryptedMessage
+	}
+	plain := make([]byte, len(info.EncryptedData))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plain, info.EncryptedData)
+	paddingSize := int(plain[len(plain)-1])
+	if paddingSize == 0 || paddingSize > blockSize {
+		utils.ShredBytes(plain)
+		return nil, ErrIncorrectPassword
+	}
+	for _, b := range plain[len(plain)-paddingSize:] {
+		if int(b) != paddingSize {
+			utils.ShredBytes(plain)
+			return nil, ErrIncorrectPassword
+		}
+	}
+	return plain[:len(plain)-paddingSize], nil
+}
+
+// Returns the PRF used by PBKDF2. The default PRF is HMAC-SHA1.
+func pbkdf2PRF(oid asn1.ObjectIdentifier) (func() hash.Hash, error) {
+	switch {
+	case len(oid) == 0, oid.Equal(oidHMACWithSHA1):
+		return sha1.New, nil
+	case oid.Equal(oidHMACWithSHA224):
+		return sha256.New224, nil
+	case oid.Equal(oidHMACWithSHA256):
+		return sha256.New, nil
+	case oid.Equal(oidHMACWithSHA384):
+		return sha512.New384, nil
+	case oid.Equal(oidHMACWithSHA512):
+		return sha512.New, nil
+	default:
+		return nil, fmt.Errorf("%w: PRF %s", ErrUnsupportedKeyEncryption, oid)
+	}
+}
+
+// Returns the block cipher constructor and its key size.
+func pbes2Cipher(oid asn1.ObjectIdentifier) (func([]byte) (cipher.Block, error), int, error) {
+	switch {
+	case oid.Equal(oidAES128CBC):
+		return aes.NewCipher, 16, nil
+	case oid.Equal(oidAES192CBC):
+		return aes.NewCipher, 24, nil
+	case oid.Equal(oidAES256CBC):
+		return aes.NewCipher, 32, nil
+	case oid.Equal(oidDESEDE3CBC):
+		return des.NewTripleDESCipher, 24, nil
+	default:
+		return nil, 0, fmt.Errorf("%w: cipher %s", ErrUnsupportedKeyEncryption, oid)
+	}
+}
@@ -0,0 +1,137 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2022, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package crypto
+
+import (
+	"crypto/rsa"
+	"encoding/asn1"
+	"encoding/pem"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadPrivateKeyWithPassword(t *testing.T) {
+	plain, err := LoadPrivateKey(getSampleFile("key.pem"))
+	require.Nil(t, err)
+
+	for _, file := range []string{"key-pkcs8-aes256.pem", "key-pkcs8-des3.pem",
+		"key-legacy-aes128.pem", "key.pem"} {
+		key, err := LoadPrivateKeyWithPassword(getSampleFile(file), "password")
+		assert.Nil(t, err, file)
+		require.IsType(t, &rsa.PrivateKey{}, key, file)
+		assert.True(t, plain.(*rsa.PrivateKey).Equal(key), file)
+	}
+
+	for _, file := range []string{"key-pkcs8-aes256.pem", "key-pkcs8-des3.pem",
+		"key-legacy-aes128.pem"} {
+		key, err := LoadPrivateKeyWithPassword(getSampleFile(file), "wrong")
+		assert.ErrorIs(t, err, ErrIncorrectPassword, file)
+		assert.Nil(t, key)
+	}
+
+	key, err := LoadPrivateKeyWithPassword(getSampleFile("key-pkcs8-scrypt.pem"), "password")
+	assert.ErrorIs(t, err, ErrUnsupportedKeyEncryption)
+	assert.Nil(t, key)
+
+	key, err = LoadPrivateKeyWithPassword(getSampleFile("cert.pem"), "password")
+	assert.ErrorIs(t, err, ErrInvalidPrivateKey)
+	assert.Nil(t, key)
+
+	key, err = LoadPrivateKeyWithPassword("this file does not exist.", "password")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	assert.Nil(t, key)
+}
+
+func TestParsePrivateKeyWithPassword_Corrupted(t *testing.T) {
+	bytes, err := os.ReadFile(getSampleFile("key-pkcs8-aes256.pem"))
+	require.Nil(t, err)
+
+	key, err := ParsePrivateKeyWithPassword(bytes[:len(bytes)/2], "password")
+	assert.ErrorIs(t, err, ErrInvalidPrivateKey)
+	assert.Nil(t, key)
+}
+
+// Rewrites the PBKDF2 iteration count of the given PKCS #8 encrypted key.
+func setPBKDF2IterationCount(t *testing.T, file string, count int) []byte {
+	bytes, err := os.ReadFile(getSampleFile(file))
+	require.Nil(t, err)
+	block, _ := pem.Decode(bytes)
+	require.NotNil(t, block)
+	var info encryptedPrivateKeyInfo
+	_, err = asn1.Unmarshal(block.Bytes, &info)
+	require.Nil(t, err)
+	var params pbes2Params
+	_, err = asn1.Unmarshal(info.Algorithm.Parameters.FullBytes, &params)
+	require.Nil(t, err)
+	var kdfParams pbkdf2Params
+	_, err = asn1.Unmarshal(params.KeyDerivationFunc.Parameters.FullBytes, &kdfParams)
+	require.Nil(t, err)
+
+	kdfParams.IterationCount = count
+	params.KeyDerivationFunc.Parameters.FullBytes, err = asn1.Marshal(kdfParams)
+	require.Nil(t, err)
+	info.Algorithm.Parameters.FullBytes, err = asn1.Marshal(params)
+	require.Nil(t, err)
+	block.Bytes, err = asn1.Marshal(info)
+	require.Nil(t, err)
+	return pem.EncodeToMemory(block)
+}
+
+func TestParsePrivateKeyWithPassword_IterationCount(t *testing.T) {
+	// The sample uses 2048 iterations.
+	key, err := ParsePrivateKeyWithPassword(setPBKDF2IterationCount(t, "key-pkcs8-aes256.pem", 2048), "password")
+	assert.Nil(t, err)
+	assert.IsType(t, &rsa.PrivateKey{}, key)
+
+	for _, count := range []int{0, -1, maxPBKDF2Iterations + 1, 1 << 40} {
+		key, err := ParsePrivateKeyWithPassword(setPBKDF2IterationCount(t, "key-pkcs8-aes256.pem", count), "password")
+		assert.ErrorIs(t, err, ErrInvalidPrivateKey, count)
+		assert.Nil(t, key)
+	}
+}
+
+func TestLoadCertificateWithEncryptedKey(t *testing.T) {
+	certFile := getSampleFile("cert.pem")
+
+	cert, err := LoadCertificateWithEncryptedKey(certFile, getSampleFile("key-pkcs8-aes256.pem"), "password")
+	assert.Nil(t, err)
+	assert.IsType(t, &rsa.PrivateKey{}, cert.PrivateKey)
+	assert.Len(t, cert.Certificate, 1)
+
+	_, err = LoadCertificateWithEncryptedKey(certFile, getSampleFile("key-legacy-aes128.pem"), "wrong")
+	assert.ErrorIs(t, err, ErrIncorrectPassword)
+
+	_, err = LoadCertificateWithEncryptedKey("this file does not exist.", getSampleFile("key.pem"), "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,84 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package crypto
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Prefix of the textual representation of the SPKI fingerprints.
+const FingerprintPrefix = "sha256/"
+
+var (
+	// The fingerprint is invalid.
+	ErrInvalidFingerprint = fmt.Errorf("invalid fingerprint")
+)
+
+/*
+Computes the SHA-256 of the SubjectPublicKeyInfo (SPKI) of the given
+certificate. Unlike the hash of the whole certificate, this fingerprint remains
+the same if the certificate is renewed with the same key pair.
+*/
+func SPKIFingerprint(cert *x509.Certificate) []byte {
+	h := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return h[:]
+}
+
+/*
+Formats the fingerprint as "sha256/<base64>", the same format used by HPKP.
+*/
+func FormatFingerprint(fingerprint []byte) string {
+	return FingerprintPrefix + base64.StdEncoding.EncodeToString(fingerprint)
+}
+
+/*
+Parses a SHA-256 fingerprint. It accepts the format "sha256/<base64>" or the
+hexadecimal representation of the hash, optionally with ':' as separators.
+*/
+func ParseFingerprint(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	var b []byte
+	var err error
+	if strings.HasPrefix(strings.ToLower(s), FingerprintPrefix) {
+		b, err = base64.StdEncoding.DecodeString(s[len(FingerprintPrefix):])
+	} else {
+		b, err = hex.DecodeString(strings.ReplaceAll(s, ":", ""))
+	}
+	if err != nil || len(b) != sha256.Size {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidFingerprint, s)
+	}
+	return b, nil
+}
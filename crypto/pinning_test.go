@@ -0,0 +1,84 @@
+// BSD 3-Clause License
+//
+// Copyright (c) 2026, InterlockLedger
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package crypto
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSPKIFingerprint(t *testing.T) {
+	certs, err := LoadCertificate("../samples/cert.pem")
+	require.Nil(t, err)
+
+	fp := SPKIFingerprint(certs[0])
+	exp := sha256.Sum256(certs[0].RawSubjectPublicKeyInfo)
+	assert.Equal(t, exp[:], fp)
+}
+
+func TestFormatFingerprint(t *testing.T) {
+	fp := make([]byte, 32)
+	fp[0] = 0xFF
+	assert.Equal(t, "sha256/"+"/wAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=", FormatFingerprint(fp))
+}
+
+func TestParseFingerprint(t *testing.T) {
+	fp := make([]byte, 32)
+	fp[0] = 0xFF
+	fp[31] = 0x01
+
+	v, err := ParseFingerprint(FormatFingerprint(fp))
+	assert.Nil(t, err)
+	assert.Equal(t, fp, v)
+
+	v, err = ParseFingerprint(" SHA256/" + FormatFingerprint(fp)[7:])
+	assert.Nil(t, err)
+	assert.Equal(t, fp, v)
+
+	v, err = ParseFingerprint("ff00000000000000000000000000000000000000000000000000000000000001")
+	assert.Nil(t, err)
+	assert.Equal(t, fp, v)
+
+	v, err = ParseFingerprint("FF:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:" +
+		"00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:01")
+	assert.Nil(t, err)
+	assert.Equal(t, fp, v)
+
+	_, err = ParseFingerprint("ff00")
+	assert.ErrorIs(t, err, ErrInvalidFingerprint)
+	_, err = ParseFingerprint("sha256/!!")
+	assert.ErrorIs(t, err, ErrInvalidFingerprint)
+	_, err = ParseFingerprint("xx")
+	assert.ErrorIs(t, err, ErrInvalidFingerprint)
+}
@@ -1,16 +1,16 @@
 module github.com/interlockledger/go-interlockledger-rest-client
 
-go 1.18
+go 1.23.0
 
 require (
 	github.com/antihax/optional v1.0.0
 	github.com/interlockledger/go-iltags v0.2.2
 	github.com/stretchr/testify v1.8.2
 	golang.org/x/crypto v0.35.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -32,7 +32,6 @@
 package main
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 
@@ -41,27 +40,10 @@ import (
 
 func main() {
 
-	// Load the configuration
-	var cfg map[string]string
-	b, err := os.ReadFile("config.json")
+	// Load the configuration and the client certificate.
+	configuration, err := client.LoadConfiguration("config.json")
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to load the configuration file: %v\n", err)
-		os.Exit(1)
-	}
-	err = json.Unmarshal(b, &cfg)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to parse the configuration file: %v\n", err)
-		os.Exit(1)
-	}
-
-	// Creates a new configuration.
-	configuration := client.NewConfiguration()
-	// Set the name of the server here
-	configuration.BasePath = cfg["basePath"]
-	// Sets the required client certificate.
-	err = configuration.SetClientCertificate("cert.pem", "key.pem")
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to load the client certificate: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Unable to load the configuration: %v\n", err)
 		os.Exit(1)
 	}
 	// Create the new client